@@ -50,6 +50,7 @@ var (
 func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 	updateMsg := make(chan string)
 	var shutdownAPIServer func() error
+	var closeEventLogFile func() error
 
 	rootCmd := &cobra.Command{
 		Use: "skaffold",
@@ -72,6 +73,7 @@ func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 			color.OverwriteDefault(color.Color(defaultColor))
 			cmd.Root().SetOutput(out)
 
+			kubectx.UseKubeConfig(opts.KubeConfig)
 			kubectx.UseKubeContext(opts.KubeContext)
 
 			// Setup logs
@@ -91,6 +93,15 @@ func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 			}
 			shutdownAPIServer = shutdown
 
+			// Save the event log to a file
+			if opts.EventLogFile != "" {
+				closeLogFile, err := event.LogEventsToFile(opts.EventLogFile)
+				if err != nil {
+					return errors.Wrap(err, "opening event log file")
+				}
+				closeEventLogFile = closeLogFile
+			}
+
 			// Print version
 			version := version.Get()
 			logrus.Infof("Skaffold %+v", version)
@@ -118,6 +129,12 @@ func NewSkaffoldCommand(out, err io.Writer) *cobra.Command {
 			if shutdownAPIServer != nil {
 				shutdownAPIServer()
 			}
+
+			if closeEventLogFile != nil {
+				if err := closeEventLogFile(); err != nil {
+					logrus.Warnf("closing event log file: %s", err)
+				}
+			}
 		},
 	}
 
@@ -50,7 +50,14 @@ E.g. build.out created by running skaffold build --quiet {{json .}} > build.out`
 
 func doDeploy(ctx context.Context, out io.Writer) error {
 	return withRunner(ctx, func(r runner.Runner, config *latest.SkaffoldConfig) error {
-		deployed, err := getArtifactsToDeploy(out, buildOutputFile.BuildArtifacts(), preBuiltImages.Artifacts(), config.Build.Artifacts)
+		var artifacts []*latest.Artifact
+		for _, artifact := range config.Build.Artifacts {
+			if opts.IsSelectedArtifact(artifact.ImageName) {
+				artifacts = append(artifacts, artifact)
+			}
+		}
+
+		deployed, err := getArtifactsToDeploy(out, buildOutputFile.BuildArtifacts(), preBuiltImages.Artifacts(), artifacts)
 		if err != nil {
 			return err
 		}
@@ -120,6 +120,14 @@ var FlagRegistry = []Flag{
 		FlagAddMethod: "IntVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
 	},
+	{
+		Name:          "event-log-file",
+		Usage:         "Save the event log to this file, as newline-delimited JSON",
+		Value:         &opts.EventLogFile,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
+	},
 	{
 		Name:          "rpc-http-port",
 		Usage:         "tcp port to expose event REST API over HTTP",
@@ -137,6 +145,14 @@ var FlagRegistry = []Flag{
 		FlagAddMethod: "StringSliceVar",
 		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
 	},
+	{
+		Name:          "static-labels",
+		Usage:         "Labels, such as a git commit or a CI build ID, added to every deployed object and created namespace. Skaffold's own labels take precedence",
+		Value:         &opts.StaticLabels,
+		DefValue:      map[string]string{},
+		FlagAddMethod: "StringToStringVar",
+		DefinedOn:     []string{"dev", "run", "debug", "deploy"},
+	},
 	{
 		Name:          "toot",
 		Usage:         "Emit a terminal beep after the deploy is complete",
@@ -229,6 +245,126 @@ var FlagRegistry = []Flag{
 		FlagAddMethod: "BoolVar",
 		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
 	},
+	{
+		Name:          "status-check-hpa",
+		Usage:         "Also wait for the horizontal pod autoscalers of deployed resources to know their current replica count",
+		Value:         &opts.StatusCheckHPAs,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
+	},
+	{
+		Name:          "status-check-poll-interval",
+		Usage:         "Interval (in ms) between two checks of the status of a deployed resource, between 50 and 10000. It doubles, up to 10000, while the API server rate-limits requests",
+		Value:         &opts.StatusCheckPollInterval,
+		DefValue:      100,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
+	},
+	{
+		Name:          "rollback-on-failure",
+		Usage:         "Roll back deployments to their previous revision when they fail to stabilize",
+		Value:         &opts.RollbackOnFailure,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
+	},
+	{
+		Name:          "validate-only",
+		Usage:         "Validate the manifests server-side, with a dry-run, instead of deploying them",
+		Value:         &opts.ValidateOnly,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"deploy", "run"},
+	},
+	{
+		Name:          "kube-contexts",
+		Usage:         "Deploy to each of the given kube-contexts in sequence, instead of the current kube-context",
+		Value:         &opts.KubeContexts,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
+	},
+	{
+		Name:          "deploy-concurrency",
+		Usage:         "Number of deployers that can run concurrently. 0 means \"no-limit\"",
+		Value:         &opts.DeployConcurrency,
+		DefValue:      1,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
+	},
+	{
+		Name:          "json-output",
+		Usage:         "Print the outcome of the status check as a JSON object instead of text",
+		Value:         &opts.JSONOutput,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
+	},
+	{
+		Name:          "deploy-retries",
+		Usage:         "Number of times a deploy that fails with a transient error is retried",
+		Value:         &opts.DeployRetries,
+		DefValue:      0,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
+	},
+	{
+		Name:          "graceful-shutdown-timeout",
+		Usage:         "Seconds to wait, when interrupted, for the deploy in progress to complete before aborting it. 0 aborts immediately",
+		Value:         &opts.GracefulShutdownTimeout,
+		DefValue:      0,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
+	},
+	{
+		Name:          "build-concurrency",
+		Usage:         "Maximum number of artifacts that can be built concurrently. 0 means \"no-limit\"",
+		Value:         &opts.BuildConcurrency,
+		DefValue:      0,
+		FlagAddMethod: "IntVar",
+		DefinedOn:     []string{"build", "dev", "debug", "run"},
+	},
+	{
+		Name:          "only",
+		Usage:         "Only build and deploy the given artifacts, and the manifests that reference them. Other resources are left untouched",
+		Value:         &opts.Only,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "deploy", "run"},
+	},
+	{
+		Name:          "prune-after-deploy",
+		Usage:         "After each successful deploy, remove the local images built by previous iterations that are no longer deployed",
+		Value:         &opts.PruneAfterDeploy,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "run"},
+	},
+	{
+		Name:          "prune-dry-run",
+		Usage:         "Only list the images that --prune-after-deploy would remove",
+		Value:         &opts.PruneDryRun,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "run"},
+	},
+	{
+		Name:          "force-conflicts",
+		Usage:         "With server-side apply, take the ownership of the fields managed by others instead of failing the deploy",
+		Value:         &opts.ForceConflicts,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run"},
+	},
+	{
+		Name:          "create-namespaces",
+		Usage:         "Create the namespaces targeted by the deployment that don't exist yet. They are deleted on cleanup",
+		Value:         &opts.CreateNamespaces,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug", "deploy", "run", "delete"},
+	},
 	{
 		Name:          "render-only",
 		Usage:         "Print rendered kubernetes manifests instead of deploying them",
@@ -237,6 +373,14 @@ var FlagRegistry = []Flag{
 		FlagAddMethod: "BoolVar",
 		DefinedOn:     []string{"dev", "run"},
 	},
+	{
+		Name:          "split-manifests",
+		Usage:         "Write each rendered kubernetes object to its own file, in the directory given by --output",
+		Value:         &opts.SplitManifests,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"render"},
+	},
 	{
 		Name:          "config",
 		Shorthand:     "c",
@@ -254,6 +398,14 @@ var FlagRegistry = []Flag{
 		FlagAddMethod: "StringVar",
 		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run"},
 	},
+	{
+		Name:          "kubeconfig",
+		Usage:         "Path to the kubeconfig file to use instead of the default one",
+		Value:         &opts.KubeConfig,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"build", "debug", "delete", "deploy", "dev", "run"},
+	},
 }
 
 var commandFlags []*pflag.Flag
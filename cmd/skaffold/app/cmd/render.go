@@ -40,7 +40,7 @@ func NewCmdRender() *cobra.Command {
 		WithCommonFlags().
 		WithFlags(func(f *pflag.FlagSet) {
 			f.BoolVar(&showBuild, "loud", false, "Show the build logs and output")
-			f.StringVar(&renderOutputPath, "output", "", "file to write rendered manifests to")
+			f.StringVar(&renderOutputPath, "output", "", "file to write rendered manifests to, or directory when using --split-manifests")
 		}).
 		NoArgs(cancelWithCtrlC(context.Background(), doRender))
 }
@@ -104,7 +104,6 @@ func TestLookupLocal(t *testing.T) {
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			t.Override(&hashForArtifact, test.hasher)
-			t.Override(&buildInProgress, func(string) {})
 
 			cache := &cache{
 				imagesAreLocal: true,
@@ -189,7 +188,6 @@ func TestLookupRemote(t *testing.T) {
 					return "", errors.New("unknown remote tag")
 				}
 			})
-			t.Override(&buildInProgress, func(string) {})
 
 			cache := &cache{
 				imagesAreLocal: false,
@@ -33,7 +33,7 @@ import (
 
 var (
 	// For testing
-	buildComplete = event.BuildComplete
+	buildCacheHit = event.BuildCacheHit
 )
 
 func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, artifacts []*latest.Artifact, buildAndTest BuildAndTestFn) ([]build.Artifact, error) {
@@ -95,7 +95,6 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		}
 
 		// Image is already built
-		buildComplete(artifact.ImageName)
 		entry := c.artifactCache[result.Hash()]
 		var uniqueTag string
 		if c.imagesAreLocal {
@@ -107,6 +106,7 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		} else {
 			uniqueTag = tags[artifact.ImageName] + "@" + entry.Digest
 		}
+		buildCacheHit(artifact.ImageName, uniqueTag)
 
 		alreadyBuilt = append(alreadyBuilt, build.Artifact{
 			ImageName: artifact.ImageName,
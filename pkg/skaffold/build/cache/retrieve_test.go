@@ -91,8 +91,7 @@ func (t stubAuth) GetAllAuthConfigs() (map[string]types.AuthConfig, error) { ret
 
 func TestCacheBuildLocal(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
-		t.Override(&buildComplete, func(string) {})
-		t.Override(&buildInProgress, func(string) {})
+		t.Override(&buildCacheHit, func(string, string) {})
 
 		tmpDir := t.NewTempDir().
 			Write("dep1", "content1").
@@ -165,8 +164,7 @@ func TestCacheBuildLocal(t *testing.T) {
 
 func TestCacheBuildRemote(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
-		t.Override(&buildComplete, func(string) {})
-		t.Override(&buildInProgress, func(string) {})
+		t.Override(&buildCacheHit, func(string, string) {})
 
 		tmpDir := t.NewTempDir().
 			Write("dep1", "content1").
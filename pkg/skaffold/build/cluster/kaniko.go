@@ -24,6 +24,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/cluster/sources"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -97,6 +98,7 @@ func args(artifact *latest.KanikoArtifact, context, tag string) ([]string, error
 	// TODO: remove since AdditionalFlags will be deprecated (priyawadhwa@)
 	if artifact.AdditionalFlags != nil {
 		logrus.Warn("The additionalFlags field in kaniko is deprecated, please consult the current schema at skaffold.dev to update your skaffold.yaml.")
+		event.Warn(event.DeprecatedField, "KANIKO_ADDITIONAL_FLAGS", "The additionalFlags field in kaniko is deprecated")
 		args = append(args, artifact.AdditionalFlags...)
 	}
 
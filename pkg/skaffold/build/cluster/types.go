@@ -41,6 +41,7 @@ type Builder struct {
 	kubeContext        string
 	timeout            time.Duration
 	insecureRegistries map[string]bool
+	maxConcurrency     int
 }
 
 // NewBuilder creates a new Builder that builds artifacts on cluster.
@@ -56,6 +57,7 @@ func NewBuilder(runCtx *runcontext.RunContext) (*Builder, error) {
 		timeout:            timeout,
 		kubeContext:        runCtx.KubeContext,
 		insecureRegistries: runCtx.InsecureRegistries,
+		maxConcurrency:     runCtx.Opts.BuildConcurrency,
 	}, nil
 }
 
@@ -84,6 +84,7 @@ type Builder struct {
 	*latest.GoogleCloudBuild
 	skipTests          bool
 	insecureRegistries map[string]bool
+	maxConcurrency     int
 }
 
 // NewBuilder creates a new Builder that builds artifacts with Google Cloud Build.
@@ -92,6 +93,7 @@ func NewBuilder(runCtx *runcontext.RunContext) *Builder {
 		GoogleCloudBuild:   runCtx.Cfg.Build.GoogleCloudBuild,
 		skipTests:          runCtx.Opts.SkipTests,
 		insecureRegistries: runCtx.InsecureRegistries,
+		maxConcurrency:     runCtx.Opts.BuildConcurrency,
 	}
 }
 
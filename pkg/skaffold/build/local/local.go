@@ -42,7 +42,11 @@ func (b *Builder) Build(ctx context.Context, out io.Writer, tags tag.ImageTags,
 	}
 	defer b.localDocker.Close()
 
-	// TODO(dgageot): parallel builds
+	// Artifacts are built in sequence on the local docker daemon, unless
+	// more concurrent builds are explicitly allowed.
+	if b.maxConcurrency > 1 {
+		return build.InParallel(ctx, out, tags, artifacts, b.buildArtifact, b.maxConcurrency)
+	}
 	return build.InSequence(ctx, out, tags, artifacts, b.buildArtifact)
 }
 
@@ -44,6 +44,7 @@ type Builder struct {
 	kubeContext        string
 	builtImages        []string
 	insecureRegistries map[string]bool
+	maxConcurrency     int
 }
 
 // external dependencies are wrapped
@@ -78,6 +79,7 @@ func NewBuilder(runCtx *runcontext.RunContext) (*Builder, error) {
 		localCluster:       localCluster,
 		pushImages:         pushImages,
 		skipTests:          runCtx.Opts.SkipTests,
+		maxConcurrency:     runCtx.Opts.BuildConcurrency,
 		prune:              runCtx.Opts.Prune(),
 		pruneChildren:      !runCtx.Opts.NoPruneChildren,
 		insecureRegistries: runCtx.InsecureRegistries,
@@ -89,6 +89,18 @@ func InParallel(ctx context.Context, out io.Writer, tags tag.ImageTags, artifact
 	return collectResults(out, artifacts, results, outputs)
 }
 
+// LimitConcurrency returns the strictest of a builder's concurrency and
+// a global limit. For both, 0 means "no-limit".
+func LimitConcurrency(concurrency, limit int) int {
+	if limit <= 0 {
+		return concurrency
+	}
+	if concurrency <= 0 || limit < concurrency {
+		return limit
+	}
+	return concurrency
+}
+
 func runBuild(ctx context.Context, cw io.WriteCloser, tags tag.ImageTags, artifact *latest.Artifact, results *sync.Map, build artifactBuilder) {
 	event.BuildInProgress(artifact.ImageName)
 
@@ -97,7 +109,7 @@ func runBuild(ctx context.Context, cw io.WriteCloser, tags tag.ImageTags, artifa
 		event.BuildFailed(artifact.ImageName, err)
 		results.Store(artifact.ImageName, err)
 	} else {
-		event.BuildComplete(artifact.ImageName)
+		event.BuildComplete(artifact.ImageName, finalTag)
 		artifact := Artifact{ImageName: artifact.ImageName, Tag: finalTag}
 		results.Store(artifact.ImageName, artifact)
 	}
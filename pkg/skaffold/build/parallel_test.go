@@ -300,6 +300,26 @@ func TestInParallelConcurrency(t *testing.T) {
 	}
 }
 
+func TestLimitConcurrency(t *testing.T) {
+	tests := []struct {
+		description string
+		concurrency int
+		limit       int
+		expected    int
+	}{
+		{description: "no limit", concurrency: 0, limit: 0, expected: 0},
+		{description: "builder limit only", concurrency: 3, limit: 0, expected: 3},
+		{description: "global limit only", concurrency: 0, limit: 2, expected: 2},
+		{description: "stricter global limit", concurrency: 5, limit: 2, expected: 2},
+		{description: "stricter builder limit", concurrency: 1, limit: 4, expected: 1},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, LimitConcurrency(test.concurrency, test.limit))
+		})
+	}
+}
+
 func TestInParallelForArgs(t *testing.T) {
 	tests := []struct {
 		description   string
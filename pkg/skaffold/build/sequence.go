@@ -48,7 +48,7 @@ func InSequence(ctx context.Context, out io.Writer, tags tag.ImageTags, artifact
 			return nil, errors.Wrapf(err, "building [%s]", artifact.ImageName)
 		}
 
-		event.BuildComplete(artifact.ImageName)
+		event.BuildComplete(artifact.ImageName, finalTag)
 
 		builds = append(builds, Artifact{
 			ImageName: artifact.ImageName,
@@ -32,39 +32,58 @@ type PortForwardOptions struct {
 // SkaffoldOptions are options that are set by command line arguments not included
 // in the config file itself
 type SkaffoldOptions struct {
-	ConfigurationFile  string
-	GlobalConfig       string
-	Cleanup            bool
-	Notification       bool
-	Tail               bool
-	TailDev            bool
-	SkipTests          bool
-	CacheArtifacts     bool
-	EnableRPC          bool
-	Force              bool
-	ForceDev           bool
-	NoPrune            bool
-	NoPruneChildren    bool
-	StatusCheck        bool
-	AutoBuild          bool
-	AutoSync           bool
-	AutoDeploy         bool
-	RenderOnly         bool
-	PortForward        PortForwardOptions
-	CustomTag          string
-	Namespace          string
-	CacheFile          string
-	Trigger            string
-	KubeContext        string
-	WatchPollInterval  int
-	DefaultRepo        string
-	CustomLabels       []string
-	TargetImages       []string
-	Profiles           []string
-	InsecureRegistries []string
-	Command            string
-	RPCPort            int
-	RPCHTTPPort        int
+	ConfigurationFile       string
+	GlobalConfig            string
+	Cleanup                 bool
+	Notification            bool
+	Tail                    bool
+	TailDev                 bool
+	SkipTests               bool
+	CacheArtifacts          bool
+	EnableRPC               bool
+	Force                   bool
+	ForceDev                bool
+	ForceConflicts          bool
+	NoPrune                 bool
+	NoPruneChildren         bool
+	PruneAfterDeploy        bool
+	PruneDryRun             bool
+	StatusCheck             bool
+	StatusCheckHPAs         bool
+	RollbackOnFailure       bool
+	AutoBuild               bool
+	AutoSync                bool
+	AutoDeploy              bool
+	RenderOnly              bool
+	SplitManifests          bool
+	ValidateOnly            bool
+	CreateNamespaces        bool
+	PortForward             PortForwardOptions
+	CustomTag               string
+	Namespace               string
+	CacheFile               string
+	Trigger                 string
+	KubeContext             string
+	KubeConfig              string
+	WatchPollInterval       int
+	StatusCheckPollInterval int
+	DefaultRepo             string
+	CustomLabels            []string
+	StaticLabels            map[string]string
+	TargetImages            []string
+	Only                    []string
+	Profiles                []string
+	InsecureRegistries      []string
+	KubeContexts            []string
+	Command                 string
+	RPCPort                 int
+	RPCHTTPPort             int
+	DeployConcurrency       int
+	BuildConcurrency        int
+	DeployRetries           int
+	GracefulShutdownTimeout int
+	JSONOutput              bool
+	EventLogFile            string
 }
 
 // Labels returns a map of labels to be applied to all deployed
@@ -118,3 +137,19 @@ func (opts *SkaffoldOptions) IsTargetImage(artifact *latest.Artifact) bool {
 
 	return false
 }
+
+// IsSelectedArtifact returns true if the artifact was selected with --only,
+// or if no artifact was selected.
+func (opts *SkaffoldOptions) IsSelectedArtifact(imageName string) bool {
+	if len(opts.Only) == 0 {
+		return true
+	}
+
+	for _, only := range opts.Only {
+		if only == imageName {
+			return true
+		}
+	}
+
+	return false
+}
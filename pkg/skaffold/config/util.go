@@ -36,6 +36,7 @@ import (
 const (
 	defaultConfigDir  = ".skaffold"
 	defaultConfigFile = "config"
+	k3dContextPrefix  = "k3d-"
 )
 
 var (
@@ -186,13 +187,25 @@ func isDefaultLocal(kubeContext string) bool {
 	return kubeContext == constants.DefaultMinikubeContext ||
 		kubeContext == constants.DefaultDockerForDesktopContext ||
 		kubeContext == constants.DefaultDockerDesktopContext ||
-		IsKindCluster(kubeContext)
+		IsKindCluster(kubeContext) ||
+		IsK3dCluster(kubeContext)
 }
 
 func IsKindCluster(kubeContext string) bool {
 	return strings.HasSuffix(kubeContext, "@kind")
 }
 
+// IsK3dCluster checks that the given kube-context was created by k3d.
+// k3d names the kube-context `k3d-<cluster name>`.
+func IsK3dCluster(kubeContext string) bool {
+	return strings.HasPrefix(kubeContext, k3dContextPrefix)
+}
+
+// K3dClusterName returns the name of the k3d cluster from its kube-context.
+func K3dClusterName(kubeContext string) string {
+	return strings.TrimPrefix(kubeContext, k3dContextPrefix)
+}
+
 func IsUpdateCheckEnabled(configfile string) bool {
 	cfg, err := GetConfigForCurrentKubectx(configfile)
 	if err != nil {
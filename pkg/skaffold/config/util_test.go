@@ -259,3 +259,24 @@ func TestIsUpdateCheckEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestIsK3dCluster(t *testing.T) {
+	tests := []struct {
+		kubeContext         string
+		expected            bool
+		expectedClusterName string
+	}{
+		{kubeContext: "k3d-k3s-default", expected: true, expectedClusterName: "k3s-default"},
+		{kubeContext: "k3d-dev", expected: true, expectedClusterName: "dev"},
+		{kubeContext: "kind-kind", expected: false},
+		{kubeContext: "minikube", expected: false},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.kubeContext, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, IsK3dCluster(test.kubeContext))
+			if test.expected {
+				t.CheckDeepEqual(test.expectedClusterName, K3dClusterName(test.kubeContext))
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package deploy
+
+import (
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+)
+
+// AppliedResource identifies a resource that a deployer created or updated.
+// An empty namespace stands for the default namespace.
+type AppliedResource struct {
+	GVK       schema.GroupVersionKind
+	Namespace string
+	Name      string
+}
+
+// manifestResources lists the resources described by a list of manifests.
+func manifestResources(manifests kubectl.ManifestList) []AppliedResource {
+	var resources []AppliedResource
+	for _, manifest := range manifests {
+		var object struct {
+			APIVersion string `yaml:"apiVersion"`
+			Kind       string `yaml:"kind"`
+			Metadata   struct {
+				Name      string `yaml:"name"`
+				Namespace string `yaml:"namespace"`
+			} `yaml:"metadata"`
+		}
+		if err := yaml.Unmarshal(manifest, &object); err != nil || object.Kind == "" {
+			continue
+		}
+
+		resources = append(resources, AppliedResource{
+			GVK:       schema.FromAPIVersionAndKind(object.APIVersion, object.Kind),
+			Namespace: object.Metadata.Namespace,
+			Name:      object.Metadata.Name,
+		})
+	}
+	return resources
+}
+
+// artifactResources lists the resources of deployed artifacts.
+func artifactResources(artifacts []Artifact) []AppliedResource {
+	var resources []AppliedResource
+	for _, a := range artifacts {
+		accessor, err := meta.Accessor(a.Obj)
+		if err != nil {
+			logrus.Debugf("unable to identify deployed object: %s", err)
+			continue
+		}
+
+		resources = append(resources, AppliedResource{
+			GVK:       a.Obj.GetObjectKind().GroupVersionKind(),
+			Namespace: a.Namespace,
+			Name:      accessor.GetName(),
+		})
+	}
+	return resources
+}
+
+// artifactImageNames lists the names under which the manifests can reference the given artifacts,
+// before and after their images are replaced.
+func artifactImageNames(builds []build.Artifact) []string {
+	var names []string
+	for _, b := range builds {
+		names = append(names, b.ImageName)
+		if parsed, err := docker.ParseReference(b.Tag); err == nil && parsed.BaseName != b.ImageName {
+			names = append(names, parsed.BaseName)
+		}
+	}
+	return names
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package deploy
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestManifestResources(t *testing.T) {
+	manifests := kubectl.ManifestList{
+		[]byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: prod`),
+		[]byte(`apiVersion: v1
+kind: Service
+metadata:
+  name: web`),
+		[]byte(`not: a resource`),
+	}
+
+	resources := manifestResources(manifests)
+
+	testutil.CheckDeepEqual(t, []AppliedResource{
+		{GVK: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, Namespace: "prod", Name: "web"},
+		{GVK: schema.GroupVersionKind{Version: "v1", Kind: "Service"}, Name: "web"},
+	}, resources)
+}
+
+func TestArtifactResources(t *testing.T) {
+	artifacts := []Artifact{{
+		Obj: &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "chart-web"},
+		},
+		Namespace: "charts",
+	}}
+
+	resources := artifactResources(artifacts)
+
+	testutil.CheckDeepEqual(t, []AppliedResource{
+		{GVK: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, Namespace: "charts", Name: "chart-web"},
+	}, resources)
+}
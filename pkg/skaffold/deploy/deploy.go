@@ -47,6 +47,7 @@ type Deployer interface {
 type Result struct {
 	err        error
 	namespaces []string
+	resources  []AppliedResource
 }
 
 func NewDeployErrorResult(err error) *Result {
@@ -61,6 +62,17 @@ func (d *Result) Namespaces() []string {
 	return d.namespaces
 }
 
+// WithResources records the resources that were created or updated by the deploy.
+func (d *Result) WithResources(resources []AppliedResource) *Result {
+	d.resources = resources
+	return d
+}
+
+// Resources returns the resources that were created or updated by the deploy.
+func (d *Result) Resources() []AppliedResource {
+	return d.resources
+}
+
 func (d *Result) GetError() error {
 	return d.err
 }
@@ -0,0 +1,210 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+)
+
+// DeployerMux forwards all method calls to the deployers it contains.
+// Deployers are run in sequence, in the order they were given, unless
+// a concurrency other than 1 is set.
+type DeployerMux struct {
+	deployers   []Deployer
+	concurrency int
+}
+
+// NewDeployerMux returns a Deployer that runs all the given deployers.
+// A concurrency of 0 means "no-limit".
+func NewDeployerMux(deployers []Deployer, concurrency int) DeployerMux {
+	return DeployerMux{
+		deployers:   deployers,
+		concurrency: concurrency,
+	}
+}
+
+func (m DeployerMux) Labels() map[string]string {
+	var all []map[string]string
+	for _, deployer := range m.deployers {
+		all = append(all, deployer.Labels())
+	}
+	return mergeLabels(all...)
+}
+
+// Deploy runs all the deployers and merges their results.
+func (m DeployerMux) Deploy(ctx context.Context, out io.Writer, builds []build.Artifact, labellers []Labeller) *Result {
+	if m.concurrency == 1 || len(m.deployers) <= 1 {
+		return m.deployInSequence(ctx, out, builds, labellers)
+	}
+	return m.deployInParallel(ctx, out, builds, labellers)
+}
+
+func (m DeployerMux) deployInSequence(ctx context.Context, out io.Writer, builds []build.Artifact, labellers []Labeller) *Result {
+	var namespaces []string
+	var resources []AppliedResource
+	for _, deployer := range m.deployers {
+		result := deployer.Deploy(ctx, out, builds, labellers)
+		if err := result.GetError(); err != nil {
+			return result
+		}
+		namespaces = append(namespaces, result.Namespaces()...)
+		resources = append(resources, result.Resources()...)
+	}
+	return NewDeploySuccessResult(uniqueSorted(namespaces)).WithResources(resources)
+}
+
+func (m DeployerMux) deployInParallel(ctx context.Context, out io.Writer, builds []build.Artifact, labellers []Labeller) *Result {
+	concurrency := m.concurrency
+	if concurrency <= 0 {
+		concurrency = len(m.deployers)
+	}
+	sem := make(chan bool, concurrency)
+
+	var wg sync.WaitGroup
+	results := make([]*Result, len(m.deployers))
+	outputs := make([]bytes.Buffer, len(m.deployers))
+
+	var errLock sync.Mutex
+	var firstErr error
+
+	wg.Add(len(m.deployers))
+	for i := range m.deployers {
+		go func(i int) {
+			defer wg.Done()
+
+			sem <- true
+			results[i] = m.deployers[i].Deploy(ctx, &outputs[i], builds, labellers)
+			<-sem
+
+			if err := results[i].GetError(); err != nil {
+				errLock.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				errLock.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// Print the outputs in the same order as the deployers.
+	var namespaces []string
+	var resources []AppliedResource
+	for i := range m.deployers {
+		out.Write(outputs[i].Bytes())
+		namespaces = append(namespaces, results[i].Namespaces()...)
+		resources = append(resources, results[i].Resources()...)
+	}
+
+	// Each deployer reports its own progress. Make sure the deploy state
+	// reflects the failure even if another deployer completed last.
+	if firstErr != nil {
+		event.DeployFailed(firstErr)
+		return NewDeployErrorResult(firstErr)
+	}
+	return NewDeploySuccessResult(uniqueSorted(namespaces)).WithResources(resources)
+}
+
+func (m DeployerMux) Dependencies() ([]string, error) {
+	var deps []string
+	for _, deployer := range m.deployers {
+		result, err := deployer.Dependencies()
+		if err != nil {
+			return nil, err
+		}
+		deps = append(deps, result...)
+	}
+	return uniqueSorted(deps), nil
+}
+
+func (m DeployerMux) Cleanup(ctx context.Context, out io.Writer) error {
+	for _, deployer := range m.deployers {
+		if err := deployer.Cleanup(ctx, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rollback uses the first deployer that supports rollbacks, since
+// rollbacks apply to all the Deployments of the current run.
+func (m DeployerMux) Rollback(ctx context.Context, out io.Writer, defaultLabeller *DefaultLabeller) ([]string, error) {
+	for _, deployer := range m.deployers {
+		if rollbacker, ok := deployer.(Rollbacker); ok {
+			return rollbacker.Rollback(ctx, out, defaultLabeller)
+		}
+	}
+	return nil, errors.New("none of the deployers support rollbacks")
+}
+
+func (m DeployerMux) Render(ctx context.Context, out io.Writer, builds []build.Artifact, filepath string) error {
+	var manifests []string
+	for _, deployer := range m.deployers {
+		var buf bytes.Buffer
+		if err := deployer.Render(ctx, &buf, builds, ""); err != nil {
+			return err
+		}
+		manifests = append(manifests, strings.TrimSpace(buf.String()))
+	}
+
+	rendered := strings.Join(manifests, "\n---\n")
+	if filepath == "" {
+		_, err := fmt.Fprintln(out, rendered)
+		return err
+	}
+
+	return errors.Wrap(ioutil.WriteFile(filepath, []byte(rendered+"\n"), 0644), "writing manifests")
+}
+
+func mergeLabels(all ...map[string]string) map[string]string {
+	merged := map[string]string{}
+	for _, l := range all {
+		merged = labels.Merge(merged, l)
+	}
+	return merged
+}
+
+func uniqueSorted(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	set := map[string]bool{}
+	for _, v := range values {
+		set[v] = true
+	}
+
+	var unique []string
+	for v := range set {
+		unique = append(unique, v)
+	}
+	sort.Strings(unique)
+	return unique
+}
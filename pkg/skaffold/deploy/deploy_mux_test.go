@@ -0,0 +1,170 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type mockDeployer struct {
+	name       string
+	namespaces []string
+	deps       []string
+	err        error
+}
+
+func (m *mockDeployer) Labels() map[string]string {
+	return map[string]string{m.name: "true"}
+}
+
+func (m *mockDeployer) Deploy(_ context.Context, out io.Writer, _ []build.Artifact, _ []Labeller) *Result {
+	fmt.Fprintf(out, "deploying %s\n", m.name)
+	if m.err != nil {
+		return NewDeployErrorResult(m.err)
+	}
+	return NewDeploySuccessResult(m.namespaces)
+}
+
+func (m *mockDeployer) Dependencies() ([]string, error) {
+	return m.deps, nil
+}
+
+func (m *mockDeployer) Cleanup(context.Context, io.Writer) error {
+	return m.err
+}
+
+func (m *mockDeployer) Render(_ context.Context, out io.Writer, _ []build.Artifact, _ string) error {
+	fmt.Fprintf(out, "name: %s\n", m.name)
+	return m.err
+}
+
+func TestDeployerMux_Deploy(t *testing.T) {
+	tests := []struct {
+		description        string
+		deployers          []Deployer
+		concurrency        int
+		shouldErr          bool
+		expectedNamespaces []string
+		expectedOutput     string
+	}{
+		{
+			description: "sequence",
+			deployers: []Deployer{
+				&mockDeployer{name: "helm", namespaces: []string{"ns2", "ns1"}},
+				&mockDeployer{name: "kubectl", namespaces: []string{"ns1"}},
+			},
+			concurrency:        1,
+			expectedNamespaces: []string{"ns1", "ns2"},
+			expectedOutput:     "deploying helm\ndeploying kubectl\n",
+		},
+		{
+			description: "sequence stops at first error",
+			deployers: []Deployer{
+				&mockDeployer{name: "helm", err: errors.New("BUG")},
+				&mockDeployer{name: "kubectl", namespaces: []string{"ns1"}},
+			},
+			concurrency:    1,
+			shouldErr:      true,
+			expectedOutput: "deploying helm\n",
+		},
+		{
+			description: "parallel",
+			deployers: []Deployer{
+				&mockDeployer{name: "helm", namespaces: []string{"ns2"}},
+				&mockDeployer{name: "kubectl", namespaces: []string{"ns1"}},
+				&mockDeployer{name: "kustomize", namespaces: []string{"ns2"}},
+			},
+			concurrency:        0,
+			expectedNamespaces: []string{"ns1", "ns2"},
+			expectedOutput:     "deploying helm\ndeploying kubectl\ndeploying kustomize\n",
+		},
+		{
+			description: "parallel with bounded concurrency",
+			deployers: []Deployer{
+				&mockDeployer{name: "helm", namespaces: []string{"ns1"}},
+				&mockDeployer{name: "kubectl", namespaces: []string{"ns2"}},
+				&mockDeployer{name: "kustomize"},
+			},
+			concurrency:        2,
+			expectedNamespaces: []string{"ns1", "ns2"},
+			expectedOutput:     "deploying helm\ndeploying kubectl\ndeploying kustomize\n",
+		},
+		{
+			description: "parallel runs all deployers on error",
+			deployers: []Deployer{
+				&mockDeployer{name: "helm"},
+				&mockDeployer{name: "kubectl", err: errors.New("BUG")},
+			},
+			concurrency:    0,
+			shouldErr:      true,
+			expectedOutput: "deploying helm\ndeploying kubectl\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var out bytes.Buffer
+
+			result := NewDeployerMux(test.deployers, test.concurrency).Deploy(context.Background(), &out, nil, nil)
+
+			t.CheckError(test.shouldErr, result.GetError())
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expectedNamespaces, result.Namespaces())
+			}
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+		})
+	}
+}
+
+func TestDeployerMux_Dependencies(t *testing.T) {
+	mux := NewDeployerMux([]Deployer{
+		&mockDeployer{name: "helm", deps: []string{"values.yaml", "Chart.yaml"}},
+		&mockDeployer{name: "kubectl", deps: []string{"k8s.yaml", "values.yaml"}},
+	}, 1)
+
+	deps, err := mux.Dependencies()
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, []string{"Chart.yaml", "k8s.yaml", "values.yaml"}, deps)
+}
+
+func TestDeployerMux_Labels(t *testing.T) {
+	mux := NewDeployerMux([]Deployer{
+		&mockDeployer{name: "helm"},
+		&mockDeployer{name: "kubectl"},
+	}, 1)
+
+	testutil.CheckDeepEqual(t, map[string]string{"helm": "true", "kubectl": "true"}, mux.Labels())
+}
+
+func TestDeployerMux_Render(t *testing.T) {
+	mux := NewDeployerMux([]Deployer{
+		&mockDeployer{name: "helm"},
+		&mockDeployer{name: "kubectl"},
+	}, 1)
+
+	var out bytes.Buffer
+	err := mux.Render(context.Background(), &out, nil, "")
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, "name: helm\n---\nname: kubectl\n", out.String())
+}
@@ -29,6 +29,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
@@ -48,21 +49,27 @@ import (
 type HelmDeployer struct {
 	*latest.HelmDeploy
 
-	kubeContext string
-	namespace   string
-	defaultRepo string
-	forceDeploy bool
+	kubeContext    string
+	kubeConfig     string
+	namespace      string
+	defaultRepo    string
+	forceDeploy    bool
+	validateOnly   bool
+	selectReleases bool
 }
 
 // NewHelmDeployer returns a new HelmDeployer for a DeployConfig filled
 // with the needed configuration for `helm`
 func NewHelmDeployer(runCtx *runcontext.RunContext) *HelmDeployer {
 	return &HelmDeployer{
-		HelmDeploy:  runCtx.Cfg.Deploy.HelmDeploy,
-		kubeContext: runCtx.KubeContext,
-		namespace:   runCtx.Opts.Namespace,
-		defaultRepo: runCtx.DefaultRepo,
-		forceDeploy: runCtx.Opts.ForceDeploy(),
+		HelmDeploy:     runCtx.Cfg.Deploy.HelmDeploy,
+		kubeContext:    runCtx.KubeContext,
+		kubeConfig:     runCtx.Opts.KubeConfig,
+		namespace:      runCtx.Opts.Namespace,
+		defaultRepo:    runCtx.DefaultRepo,
+		forceDeploy:    runCtx.Opts.ForceDeploy(),
+		validateOnly:   runCtx.Opts.ValidateOnly,
+		selectReleases: len(runCtx.Opts.Only) > 0,
 	}
 }
 
@@ -78,7 +85,14 @@ func (h *HelmDeployer) Deploy(ctx context.Context, out io.Writer, builds []build
 	event.DeployInProgress()
 	nsMap := map[string]struct{}{}
 
+	// Helm renders and installs each release in a single step.
+	start := time.Now()
 	for _, r := range h.Releases {
+		if h.selectReleases && !releaseUsesImages(r, builds) {
+			logrus.Infof("Skipping release %s: it doesn't use any of the selected artifacts", r.Name)
+			continue
+		}
+
 		results, err := h.deployRelease(ctx, out, r, builds)
 		if err != nil {
 			releaseName, _ := evaluateReleaseName(r.Name)
@@ -95,6 +109,12 @@ func (h *HelmDeployer) Deploy(ctx context.Context, out io.Writer, builds []build
 
 		dRes = append(dRes, results...)
 	}
+	event.DeployPhaseCompleted(event.ApplyPhase, time.Since(start))
+
+	if h.validateOnly {
+		event.DeployValidated()
+		return NewDeploySuccessResult(nil)
+	}
 
 	event.DeployComplete()
 
@@ -107,7 +127,19 @@ func (h *HelmDeployer) Deploy(ctx context.Context, out io.Writer, builds []build
 		namespaces = append(namespaces, ns)
 	}
 
-	return NewDeploySuccessResult(namespaces)
+	return NewDeploySuccessResult(namespaces).WithResources(artifactResources(dRes))
+}
+
+// releaseUsesImages checks whether a release sets one of the given images in its values.
+func releaseUsesImages(r latest.HelmRelease, builds []build.Artifact) bool {
+	for _, b := range builds {
+		for _, imageName := range r.Values {
+			if imageName == b.ImageName {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func (h *HelmDeployer) Dependencies() ([]string, error) {
@@ -158,7 +190,11 @@ func (h *HelmDeployer) Cleanup(ctx context.Context, out io.Writer) error {
 }
 
 func (h *HelmDeployer) helm(ctx context.Context, out io.Writer, useSecrets bool, arg ...string) error {
-	args := append([]string{"--kube-context", h.kubeContext}, arg...)
+	args := []string{"--kube-context", h.kubeContext}
+	if h.kubeConfig != "" {
+		args = append(args, "--kubeconfig", h.kubeConfig)
+	}
+	args = append(args, arg...)
 	args = append(args, h.Flags.Global...)
 
 	if useSecrets {
@@ -331,9 +367,16 @@ func (h *HelmDeployer) deployRelease(ctx context.Context, out io.Writer, r lates
 			warnings.Printf("image [%s] is not used.", build.Tag)
 			warnings.Printf("image [%s] is used instead.", build.ImageName)
 			warnings.Printf("See helm sample for how to replace image names with their actual tags: https://github.com/GoogleContainerTools/skaffold/blob/master/examples/helm-deployment/skaffold.yaml")
+			event.Warn(event.ImageMismatch, "IMAGE_NOT_SET", fmt.Sprintf("image [%s] is not used, [%s] is used instead", build.Tag, build.ImageName))
 		}
 	}
 
+	if h.validateOnly {
+		// The release is rendered and validated by tiller without being installed.
+		args = append(args, "--dry-run")
+		return nil, h.helm(ctx, out, r.UseHelmSecrets, args...)
+	}
+
 	if r.Wait {
 		args = append(args, "--wait")
 	}
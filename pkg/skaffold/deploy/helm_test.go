@@ -595,6 +595,16 @@ func TestParseHelmRelease(t *testing.T) {
 	}
 }
 
+func TestReleaseUsesImages(t *testing.T) {
+	release := latest.HelmRelease{
+		Name:   "frontend",
+		Values: map[string]string{"image": "frontend"},
+	}
+
+	testutil.CheckDeepEqual(t, true, releaseUsesImages(release, []build.Artifact{{ImageName: "frontend", Tag: "frontend:v1"}}))
+	testutil.CheckDeepEqual(t, false, releaseUsesImages(release, []build.Artifact{{ImageName: "backend", Tag: "backend:v1"}}))
+}
+
 func TestExtractChartFilename(t *testing.T) {
 	out, err := extractChartFilename(
 		"Successfully packaged chart and saved it to: /var/folders/gm/rrs_712142x8vymmd7xq7h340000gn/T/foo-1.2.3-dirty.tgz\n",
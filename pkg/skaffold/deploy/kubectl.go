@@ -24,6 +24,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/segmentio/textio"
@@ -49,6 +50,7 @@ type KubectlDeployer struct {
 	kubectl            deploy.CLI
 	defaultRepo        string
 	insecureRegistries map[string]bool
+	selectManifests    bool
 }
 
 // NewKubectlDeployer returns a new KubectlDeployer for a DeployConfig filled
@@ -58,12 +60,18 @@ func NewKubectlDeployer(runCtx *runcontext.RunContext) *KubectlDeployer {
 		KubectlDeploy: runCtx.Cfg.Deploy.KubectlDeploy,
 		workingDir:    runCtx.WorkingDir,
 		kubectl: deploy.CLI{
-			CLI:         kubectl.NewFromRunContext(runCtx),
-			Flags:       runCtx.Cfg.Deploy.KubectlDeploy.Flags,
-			ForceDeploy: runCtx.Opts.ForceDeploy(),
+			CLI:             kubectl.NewFromRunContext(runCtx),
+			Flags:           runCtx.Cfg.Deploy.KubectlDeploy.Flags,
+			ForceDeploy:     runCtx.Opts.ForceDeploy(),
+			ValidateOnly:    runCtx.Opts.ValidateOnly,
+			ServerSideApply: runCtx.Cfg.Deploy.KubectlDeploy.ServerSideApply,
+			ForceConflicts:  runCtx.Opts.ForceConflicts,
+			Dependencies:    runCtx.Cfg.Deploy.Dependencies,
+			WaitTimeout:     getDeadline(runCtx.Cfg.Deploy.StatusCheckDeadlineSeconds),
 		},
 		defaultRepo:        runCtx.DefaultRepo,
 		insecureRegistries: runCtx.InsecureRegistries,
+		selectManifests:    len(runCtx.Opts.Only) > 0,
 	}
 }
 
@@ -77,6 +85,7 @@ func (k *KubectlDeployer) Labels() map[string]string {
 // runs `kubectl apply` on those manifests
 func (k *KubectlDeployer) Deploy(ctx context.Context, out io.Writer, builds []build.Artifact, labellers []Labeller) *Result {
 	event.DeployInProgress()
+	start := time.Now()
 	manifests, err := k.renderManifests(ctx, out, builds)
 
 	if err != nil {
@@ -84,7 +93,16 @@ func (k *KubectlDeployer) Deploy(ctx context.Context, out io.Writer, builds []bu
 		return NewDeployErrorResult(err)
 	}
 
+	if k.selectManifests {
+		manifests, err = manifests.SelectImages(artifactImageNames(builds))
+		if err != nil {
+			event.DeployFailed(err)
+			return NewDeployErrorResult(errors.Wrap(err, "selecting manifests"))
+		}
+	}
+
 	if len(manifests) == 0 {
+		event.DeployPhaseCompleted(event.RenderPhase, time.Since(start))
 		event.DeployComplete()
 		return NewDeploySuccessResult(nil)
 	}
@@ -94,6 +112,7 @@ func (k *KubectlDeployer) Deploy(ctx context.Context, out io.Writer, builds []bu
 		event.DeployFailed(err)
 		return NewDeployErrorResult(errors.Wrap(err, "setting labels in manifests"))
 	}
+	event.DeployPhaseCompleted(event.RenderPhase, time.Since(start))
 
 	namespaces, err := manifests.CollectNamespaces()
 	if err != nil {
@@ -101,13 +120,25 @@ func (k *KubectlDeployer) Deploy(ctx context.Context, out io.Writer, builds []bu
 			"This might cause port-forward and deploy health-check to fail."))
 	}
 
+	applyStart := time.Now()
 	if err := k.kubectl.Apply(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
 		event.DeployFailed(err)
 		return NewDeployErrorResult(errors.Wrap(err, "kubectl error"))
 	}
+	event.DeployPhaseCompleted(event.ApplyPhase, time.Since(applyStart))
+
+	if k.kubectl.ValidateOnly {
+		event.DeployValidated()
+		return NewDeploySuccessResult(namespaces)
+	}
 
 	event.DeployComplete()
-	return NewDeploySuccessResult(namespaces)
+	return NewDeploySuccessResult(namespaces).WithResources(manifestResources(manifests))
+}
+
+// Rollback rolls back the Deployments of the current run to their previous revision.
+func (k *KubectlDeployer) Rollback(ctx context.Context, out io.Writer, defaultLabeller *DefaultLabeller) ([]string, error) {
+	return rollbackDeployments(ctx, out, k.kubectl.CLI, defaultLabeller)
 }
 
 // Cleanup deletes what was deployed by calling Deploy.
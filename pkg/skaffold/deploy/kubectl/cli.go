@@ -17,8 +17,12 @@ limitations under the License.
 package kubectl
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -32,10 +36,26 @@ type CLI struct {
 	*pkgkubectl.CLI
 	Flags latest.KubectlFlags
 
-	ForceDeploy   bool
+	ForceDeploy  bool
+	ValidateOnly bool
+
+	// ServerSideApply applies the manifests with server-side apply, so that
+	// the fields owned by other field managers are respected.
+	ServerSideApply bool
+	// ForceConflicts takes the ownership of the conflicting fields when
+	// a server-side apply fails because of conflicts.
+	ForceConflicts bool
+
+	// Dependencies are the ordering constraints between the deployed resources.
+	Dependencies []latest.DeployDependency
+	// WaitTimeout is how long to wait for a resource that others depend on.
+	WaitTimeout time.Duration
+
 	previousApply ManifestList
 }
 
+const defaultWaitTimeout = 10 * time.Minute
+
 // Delete runs `kubectl delete` on a list of manifests.
 func (c *CLI) Delete(ctx context.Context, out io.Writer, manifests ManifestList) error {
 	args := c.args(c.Flags.Delete, "--ignore-not-found=true", "-f", "-")
@@ -48,24 +68,106 @@ func (c *CLI) Delete(ctx context.Context, out io.Writer, manifests ManifestList)
 
 // Apply runs `kubectl apply` on a list of manifests.
 func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests ManifestList) error {
+	if c.ValidateOnly {
+		return c.validate(ctx, out, manifests)
+	}
+
+	args := []string{"-f", "-"}
+	switch {
+	case c.ServerSideApply:
+		args = append(args, "--server-side", "--field-manager="+FieldManager)
+		if c.ForceDeploy {
+			logrus.Warnln("--force is ignored with server-side apply")
+		}
+	case c.ForceDeploy:
+		args = append(args, "--force")
+	}
+
+	// Custom resources can only be applied once their definitions are established.
+	all := manifests
+	if crds, others := splitCRDs(manifests); len(crds) > 0 && len(others) > 0 {
+		if err := c.applyCRDs(ctx, out, crds, args); err != nil {
+			return err
+		}
+		manifests = others
+	}
+
+	if len(c.Dependencies) > 0 {
+		err := c.applyInTiers(ctx, out, manifests, args)
+		if err == nil {
+			c.previousApply = all
+		}
+		return err
+	}
+
 	// Only redeploy modified or new manifests
 	// TODO(dgageot): should we delete a manifest that was deployed and is not anymore?
 	updated := c.previousApply.Diff(manifests)
 	logrus.Debugln(len(manifests), "manifests to deploy.", len(updated), "are updated or new")
-	c.previousApply = manifests
+	c.previousApply = all
 	if len(updated) == 0 {
 		return nil
 	}
 
-	args := []string{"-f", "-"}
-	if c.ForceDeploy {
-		args = append(args, "--force")
+	return c.apply(ctx, out, updated, args)
+}
+
+// apply runs `kubectl apply` on a list of manifests. With server-side apply,
+// conflicts are reported as a FieldConflictError, unless they can be forced.
+func (c *CLI) apply(ctx context.Context, out io.Writer, manifests ManifestList, args []string) error {
+	if !c.ServerSideApply {
+		if err := c.Run(ctx, manifests.Reader(), out, "apply", c.args(c.Flags.Apply, args...)...); err != nil {
+			return errors.Wrap(err, "kubectl apply")
+		}
+		return nil
+	}
+
+	var buf bytes.Buffer
+	err := c.Run(ctx, manifests.Reader(), &buf, "apply", c.args(c.Flags.Apply, args...)...)
+	if err == nil {
+		out.Write(buf.Bytes())
+		return nil
+	}
+
+	conflicts := parseFieldConflicts(buf.String())
+	if len(conflicts) == 0 {
+		out.Write(buf.Bytes())
+		return errors.Wrap(err, "kubectl apply")
+	}
+	if !c.ForceConflicts {
+		return errors.Wrap(&FieldConflictError{Conflicts: conflicts}, "kubectl apply")
 	}
 
-	if err := c.Run(ctx, updated.Reader(), out, "apply", c.args(c.Flags.Apply, args...)...); err != nil {
+	logrus.Warnf("Taking ownership of fields managed by others: %s", strings.Join(conflicts, ", "))
+	forceArgs := append(append([]string{}, args...), "--force-conflicts")
+	if err := c.Run(ctx, manifests.Reader(), out, "apply", c.args(c.Flags.Apply, forceArgs...)...); err != nil {
 		return errors.Wrap(err, "kubectl apply")
 	}
+	return nil
+}
+
+// validate runs a server-side dry-run of `kubectl apply` on each manifest,
+// so that the cluster can validate them without being modified.
+// The validation errors of all the manifests are returned together.
+func (c *CLI) validate(ctx context.Context, out io.Writer, manifests ManifestList) error {
+	var failures []string
+	for _, manifest := range manifests {
+		var buf bytes.Buffer
+		args := c.args(c.Flags.Apply, "--dry-run=server", "-f", "-")
+		if err := c.Run(ctx, bytes.NewReader(manifest), &buf, "apply", args...); err != nil {
+			details := strings.TrimSpace(buf.String())
+			if details == "" {
+				details = err.Error()
+			}
+			failures = append(failures, fmt.Sprintf("%s: %s", objectName(manifest), details))
+			continue
+		}
+		out.Write(buf.Bytes())
+	}
 
+	if len(failures) > 0 {
+		return fmt.Errorf("%d/%d manifest(s) failed validation:\n - %s", len(failures), len(manifests), strings.Join(failures, "\n - "))
+	}
 	return nil
 }
 
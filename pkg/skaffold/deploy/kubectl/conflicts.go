@@ -0,0 +1,81 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// FieldManager is the name of the field manager used by Skaffold
+// with server-side apply.
+const FieldManager = "skaffold"
+
+// FieldConflictError is returned when a server-side apply fails because
+// some of the applied fields are owned by other field managers.
+type FieldConflictError struct {
+	// Conflicts describes the conflicting fields, as reported by kubectl.
+	Conflicts []string
+}
+
+func (e *FieldConflictError) Error() string {
+	return fmt.Sprintf("server-side apply conflicts with other field managers:\n - %s", strings.Join(e.Conflicts, "\n - "))
+}
+
+// IsFieldConflict tells if an error was caused by server-side apply conflicts.
+func IsFieldConflict(err error) bool {
+	_, ok := errors.Cause(err).(*FieldConflictError)
+	return ok
+}
+
+// parseFieldConflicts extracts the conflicts from the output of a
+// failed `kubectl apply --server-side`. It returns nil if the apply
+// didn't fail because of conflicts.
+//
+// kubectl reports conflicts like this:
+//
+//	error: Apply failed with 1 conflict: conflict with "operator" using apps/v1: .spec.replicas
+//
+// or, for several fields:
+//
+//	error: Apply failed with 2 conflicts: conflicts with "operator" using apps/v1:
+//	- .spec.replicas
+//	- .spec.template.spec.containers[name="app"].image
+func parseFieldConflicts(output string) []string {
+	var conflicts []string
+
+	inConflicts := false
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		if i := strings.Index(line, "Apply failed with"); i >= 0 {
+			inConflicts = true
+			conflicts = append(conflicts, strings.TrimSuffix(line[i:], ":"))
+			continue
+		}
+
+		if inConflicts && strings.HasPrefix(line, "- ") {
+			conflicts = append(conflicts, strings.TrimPrefix(line, "- "))
+			continue
+		}
+		inConflicts = false
+	}
+
+	return conflicts
+}
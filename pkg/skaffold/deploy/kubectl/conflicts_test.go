@@ -0,0 +1,77 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"errors"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestParseFieldConflicts(t *testing.T) {
+	tests := []struct {
+		description string
+		output      string
+		expected    []string
+	}{
+		{
+			description: "no conflict",
+			output:      "error: unable to recognize \"STDIN\": no matches for kind \"Foo\"\n",
+		},
+		{
+			description: "single conflict",
+			output: `error: Apply failed with 1 conflict: conflict with "operator" using apps/v1: .spec.replicas
+Please review the fields above--they currently have other managers. Here
+are the ways you can resolve this warning:
+`,
+			expected: []string{`Apply failed with 1 conflict: conflict with "operator" using apps/v1: .spec.replicas`},
+		},
+		{
+			description: "multiple conflicts",
+			output: `deployment.apps/web serverside-applied
+error: Apply failed with 2 conflicts: conflicts with "operator" using apps/v1:
+- .spec.replicas
+- .spec.template.spec.containers[name="app"].image
+Please review the fields above--they currently have other managers.
+`,
+			expected: []string{
+				`Apply failed with 2 conflicts: conflicts with "operator" using apps/v1`,
+				".spec.replicas",
+				`.spec.template.spec.containers[name="app"].image`,
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			conflicts := parseFieldConflicts(test.output)
+
+			t.CheckDeepEqual(test.expected, conflicts)
+		})
+	}
+}
+
+func TestIsFieldConflict(t *testing.T) {
+	conflict := &FieldConflictError{Conflicts: []string{".spec.replicas"}}
+
+	testutil.CheckDeepEqual(t, true, IsFieldConflict(conflict))
+	testutil.CheckDeepEqual(t, true, IsFieldConflict(pkgerrors.Wrap(conflict, "kubectl apply")))
+	testutil.CheckDeepEqual(t, false, IsFieldConflict(errors.New("kubectl apply")))
+	testutil.CheckDeepEqual(t, false, IsFieldConflict(nil))
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+)
+
+const crdKind = "customresourcedefinition"
+
+// splitCRDs separates the CustomResourceDefinitions from the other manifests.
+func splitCRDs(manifests ManifestList) (ManifestList, ManifestList) {
+	var crds, others ManifestList
+	for _, manifest := range manifests {
+		if strings.HasPrefix(objectName(manifest), crdKind+"/") {
+			crds = append(crds, manifest)
+		} else {
+			others = append(others, manifest)
+		}
+	}
+	return crds, others
+}
+
+// applyCRDs applies CustomResourceDefinitions and waits for the new or updated ones
+// to be established, so that the custom resources that use them can be applied next.
+func (c *CLI) applyCRDs(ctx context.Context, out io.Writer, crds ManifestList, args []string) error {
+	updated := c.previousApply.Diff(crds)
+	logrus.Debugln(len(crds), "CustomResourceDefinitions to deploy.", len(updated), "are updated or new")
+	if len(updated) == 0 {
+		return nil
+	}
+
+	if err := c.apply(ctx, out, updated, args); err != nil {
+		return err
+	}
+
+	for _, crd := range updated {
+		if err := c.waitForEstablished(ctx, out, crd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForEstablished blocks until a CustomResourceDefinition is established.
+func (c *CLI) waitForEstablished(ctx context.Context, out io.Writer, crd []byte) error {
+	name := objectName(crd)
+	timeout := fmt.Sprintf("--timeout=%v", c.waitTimeout())
+
+	event.ResourceStatusCheckEventUpdated(name, "waiting for the CustomResourceDefinition to be established")
+	var buf bytes.Buffer
+	if err := c.Run(ctx, nil, &buf, "wait", "--for=condition=established", name, timeout); err != nil {
+		err = errors.Wrapf(err, "waiting for %s: %s", name, strings.TrimSpace(buf.String()))
+		event.ResourceStatusCheckEventFailed(name, err)
+		return err
+	}
+
+	fmt.Fprintf(out, " - %s is established.\n", name)
+	event.ResourceStatusCheckEventSucceeded(name)
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubectl
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const (
+	crdManifest = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: crontabs.stable.example.com`
+	crManifest = `apiVersion: stable.example.com/v1
+kind: CronTab
+metadata:
+  name: my-crontab`
+)
+
+func TestSplitCRDs(t *testing.T) {
+	crds, others := splitCRDs(ManifestList{[]byte(crManifest), []byte(crdManifest), []byte(webManifest)})
+
+	testutil.CheckDeepEqual(t, ManifestList{[]byte(crdManifest)}, crds)
+	testutil.CheckDeepEqual(t, ManifestList{[]byte(crManifest), []byte(webManifest)}, others)
+}
+
+func TestApplyCRDsFirst(t *testing.T) {
+	tests := []struct {
+		description string
+		manifests   ManifestList
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "apply and wait for the CRDs first",
+			manifests:   ManifestList{[]byte(crManifest), []byte(crdManifest)},
+			commands: testutil.
+				CmdRunInput("kubectl --context kubecontext apply -f -", crdManifest).
+				AndRun("kubectl --context kubecontext wait --for=condition=established customresourcedefinition/crontabs.stable.example.com --timeout=1m0s").
+				AndRunInput("kubectl --context kubecontext apply -f -", crManifest),
+		},
+		{
+			description: "no custom resources",
+			manifests:   ManifestList{[]byte(crdManifest)},
+			commands:    testutil.CmdRunInput("kubectl --context kubecontext apply -f -", crdManifest),
+		},
+		{
+			description: "CRD not established",
+			manifests:   ManifestList{[]byte(crManifest), []byte(crdManifest)},
+			commands: testutil.
+				CmdRunInput("kubectl --context kubecontext apply -f -", crdManifest).
+				AndRunErr("kubectl --context kubecontext wait --for=condition=established customresourcedefinition/crontabs.stable.example.com --timeout=1m0s", errors.New("timed out")),
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			event.InitializeState(latest.BuildConfig{})
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			cli := CLI{
+				CLI:         &pkgkubectl.CLI{KubeContext: "kubecontext"},
+				WaitTimeout: time.Minute,
+			}
+			err := cli.Apply(context.Background(), ioutil.Discard, test.manifests)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestUnchangedCRDsAreNotWaitedFor(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		event.InitializeState(latest.BuildConfig{})
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunInput("kubectl --context kubecontext apply -f -", crdManifest).
+			AndRun("kubectl --context kubecontext wait --for=condition=established customresourcedefinition/crontabs.stable.example.com --timeout=1m0s").
+			AndRunInput("kubectl --context kubecontext apply -f -", crManifest).
+			AndRunInput("kubectl --context kubecontext apply -f -", webManifest))
+
+		cli := CLI{
+			CLI:         &pkgkubectl.CLI{KubeContext: "kubecontext"},
+			WaitTimeout: time.Minute,
+		}
+		err := cli.Apply(context.Background(), ioutil.Discard, ManifestList{[]byte(crdManifest), []byte(crManifest)})
+		t.CheckNoError(err)
+
+		err = cli.Apply(context.Background(), ioutil.Discard, ManifestList{[]byte(crdManifest), []byte(crManifest), []byte(webManifest)})
+		t.CheckNoError(err)
+	})
+}
@@ -17,11 +17,14 @@ limitations under the License.
 package kubectl
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/warnings"
 )
@@ -59,6 +62,34 @@ func (is *imageSaver) NewValue(old interface{}) (bool, interface{}) {
 	return false, nil
 }
 
+// SelectImages keeps only the manifests that reference at least one of the given images.
+// Images are compared by their base name, so that tagged images match too.
+func (l *ManifestList) SelectImages(imageNames []string) (ManifestList, error) {
+	selected := map[string]bool{}
+	for _, imageName := range imageNames {
+		selected[imageName] = true
+	}
+
+	var kept ManifestList
+	for _, manifest := range *l {
+		single := ManifestList{manifest}
+		images, err := single.GetImages()
+		if err != nil {
+			return nil, errors.Wrap(err, "reading images")
+		}
+
+		for _, image := range images {
+			if selected[image.ImageName] {
+				kept = append(kept, manifest)
+				break
+			}
+		}
+	}
+
+	logrus.Debugln(len(kept), "out of", len(*l), "manifests reference the selected artifacts")
+	return kept, nil
+}
+
 // ReplaceImages replaces image names in a list of manifests.
 func (l *ManifestList) ReplaceImages(builds []build.Artifact, defaultRepo string) (ManifestList, error) {
 	replacer := newImageReplacer(builds, defaultRepo)
@@ -140,6 +171,7 @@ func (r *imageReplacer) Check() {
 	for imageName := range r.tagsByImageName {
 		if !r.found[imageName] {
 			warnings.Printf("image [%s] is not used by the deployment", imageName)
+			event.Warn(event.ImageMismatch, "IMAGE_NOT_USED", fmt.Sprintf("image [%s] is not used by the deployment", imageName))
 		}
 	}
 }
@@ -127,3 +127,32 @@ image:
 
 	testutil.CheckErrorAndDeepEqual(t, false, err, manifests.String(), output.String())
 }
+
+func TestSelectImages(t *testing.T) {
+	frontend := []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: frontend
+spec:
+  containers:
+  - image: gcr.io/project/frontend:v1
+    name: frontend`)
+	backend := []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: backend
+spec:
+  containers:
+  - image: backend
+    name: backend`)
+	service := []byte(`apiVersion: v1
+kind: Service
+metadata:
+  name: frontend`)
+
+	manifests := ManifestList{frontend, backend, service}
+
+	selected, err := manifests.SelectImages([]string{"gcr.io/project/frontend"})
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, ManifestList{frontend}, selected)
+}
@@ -21,6 +21,8 @@ import (
 	"io"
 	"regexp"
 	"strings"
+
+	"gopkg.in/yaml.v2"
 )
 
 // ManifestList is a list of yaml manifests.
@@ -113,3 +115,18 @@ func (l *ManifestList) Diff(latest ManifestList) ManifestList {
 func (l *ManifestList) Reader() io.Reader {
 	return strings.NewReader(l.String())
 }
+
+// objectName returns the kind and name of the object described by a manifest.
+func objectName(manifest []byte) string {
+	var object struct {
+		Kind     string `yaml:"kind"`
+		Metadata struct {
+			Name string `yaml:"name"`
+		} `yaml:"metadata"`
+	}
+	if err := yaml.Unmarshal(manifest, &object); err != nil || object.Kind == "" {
+		return "unknown object"
+	}
+
+	return strings.ToLower(object.Kind) + "/" + object.Metadata.Name
+}
@@ -138,3 +138,8 @@ func TestAppendServiceAndRoleBinding(t *testing.T) {
 	testutil.CheckDeepEqual(t, service, string(manifests[1]))
 	testutil.CheckDeepEqual(t, manifests.String(), roleBinding+"\n---\n"+service)
 }
+
+func TestObjectName(t *testing.T) {
+	testutil.CheckDeepEqual(t, "pod/leeroy-web", objectName([]byte(pod1)))
+	testutil.CheckDeepEqual(t, "unknown object", objectName([]byte("not: [valid")))
+}
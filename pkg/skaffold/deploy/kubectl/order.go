@@ -0,0 +1,219 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package kubectl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// CheckDependencyCycles makes sure that the deploy dependencies don't form a cycle.
+func CheckDependencyCycles(deps []latest.DeployDependency) error {
+	graph := dependencyGraph(deps)
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	marks := map[string]int{}
+
+	var visit func(node string, path []string) error
+	visit = func(node string, path []string) error {
+		switch marks[node] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("cycle in deploy dependencies: %s", strings.Join(append(path, node), " -> "))
+		}
+
+		marks[node] = visiting
+		for _, dep := range graph[node] {
+			if err := visit(dep, append(path, node)); err != nil {
+				return err
+			}
+		}
+		marks[node] = visited
+		return nil
+	}
+
+	for _, dep := range deps {
+		if err := visit(dep.Resource, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DependencyTiers groups resources, given in the `kind/name` format, into tiers
+// such that every resource only depends on resources of previous tiers.
+// Dependencies on resources that are not in the list are ignored.
+// The order of the resources is preserved within a tier.
+func DependencyTiers(resources []string, deps []latest.DeployDependency) ([][]string, error) {
+	if err := CheckDependencyCycles(deps); err != nil {
+		return nil, err
+	}
+
+	graph := dependencyGraph(deps)
+	present := map[string]bool{}
+	for _, r := range resources {
+		present[r] = true
+	}
+
+	depths := map[string]int{}
+	var depth func(node string) int
+	depth = func(node string) int {
+		if d, found := depths[node]; found {
+			return d
+		}
+
+		d := 0
+		for _, dep := range graph[node] {
+			if present[dep] {
+				if dd := depth(dep) + 1; dd > d {
+					d = dd
+				}
+			}
+		}
+		depths[node] = d
+		return d
+	}
+
+	var tiers [][]string
+	for _, r := range resources {
+		d := depth(r)
+		for len(tiers) <= d {
+			tiers = append(tiers, nil)
+		}
+		tiers[d] = append(tiers[d], r)
+	}
+	return tiers, nil
+}
+
+func dependencyGraph(deps []latest.DeployDependency) map[string][]string {
+	graph := map[string][]string{}
+	for _, dep := range deps {
+		graph[dep.Resource] = append(graph[dep.Resource], dep.DependsOn...)
+	}
+	return graph
+}
+
+// applyInTiers applies the manifests tier by tier, waiting for each tier
+// to be ready before applying the next one. The last tier is left to the
+// regular status check.
+func (c *CLI) applyInTiers(ctx context.Context, out io.Writer, manifests ManifestList, args []string) error {
+	var names []string
+	byName := map[string]ManifestList{}
+	for _, manifest := range manifests {
+		name := objectName(manifest)
+		if _, found := byName[name]; !found {
+			names = append(names, name)
+		}
+		byName[name] = append(byName[name], manifest)
+	}
+
+	tiers, err := DependencyTiers(names, c.Dependencies)
+	if err != nil {
+		return err
+	}
+
+	for i, tier := range tiers {
+		var tierManifests ManifestList
+		for _, name := range tier {
+			tierManifests = append(tierManifests, byName[name]...)
+		}
+
+		updated := c.previousApply.Diff(tierManifests)
+		logrus.Debugf("Tier %d/%d: %d manifests to deploy. %d are updated or new", i+1, len(tiers), len(tierManifests), len(updated))
+		if len(updated) > 0 {
+			if err := c.apply(ctx, out, updated, args); err != nil {
+				return err
+			}
+		}
+
+		if i == len(tiers)-1 {
+			break
+		}
+		for _, manifest := range tierManifests {
+			if err := c.waitForReady(ctx, out, manifest); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// waitForReady blocks until the resource described by a manifest is ready.
+// Only workloads and jobs are waited for. Other resources are ready as soon as
+// they are applied.
+func (c *CLI) waitForReady(ctx context.Context, out io.Writer, manifest []byte) error {
+	name := objectName(manifest)
+	timeout := fmt.Sprintf("--timeout=%v", c.waitTimeout())
+
+	var args []string
+	switch strings.Split(name, "/")[0] {
+	case "deployment", "statefulset", "daemonset":
+		args = []string{"rollout", "status", name, timeout}
+	case "job":
+		args = []string{"wait", "--for=condition=complete", name, timeout}
+	default:
+		return nil
+	}
+
+	event.ResourceStatusCheckEventUpdated(name, "waiting for the resource to be ready before deploying its dependents")
+	var buf bytes.Buffer
+	if err := c.RunInNamespace(ctx, nil, &buf, args[0], objectNamespace(manifest), args[1:]...); err != nil {
+		err = errors.Wrapf(err, "waiting for %s: %s", name, strings.TrimSpace(buf.String()))
+		event.ResourceStatusCheckEventFailed(name, err)
+		return err
+	}
+
+	fmt.Fprintf(out, " - %s is ready.\n", name)
+	event.ResourceStatusCheckEventSucceeded(name)
+	return nil
+}
+
+func (c *CLI) waitTimeout() time.Duration {
+	if c.WaitTimeout > 0 {
+		return c.WaitTimeout
+	}
+	return defaultWaitTimeout
+}
+
+// objectNamespace returns the namespace of the object described by a manifest.
+func objectNamespace(manifest []byte) string {
+	var object struct {
+		Metadata struct {
+			Namespace string `yaml:"namespace"`
+		} `yaml:"metadata"`
+	}
+	if err := yaml.Unmarshal(manifest, &object); err != nil {
+		return ""
+	}
+
+	return object.Metadata.Namespace
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package kubectl
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	pkgkubectl "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const (
+	dbManifest = `apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db`
+	migrateManifest = `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  namespace: jobs`
+	webManifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web`
+)
+
+func TestDependencyTiers(t *testing.T) {
+	tests := []struct {
+		description string
+		resources   []string
+		deps        []latest.DeployDependency
+		expected    [][]string
+		shouldErr   bool
+	}{
+		{
+			description: "no dependencies",
+			resources:   []string{"deployment/web", "service/web"},
+			expected:    [][]string{{"deployment/web", "service/web"}},
+		},
+		{
+			description: "chain",
+			resources:   []string{"deployment/web", "service/web", "job/migrate", "statefulset/db"},
+			deps: []latest.DeployDependency{
+				{Resource: "deployment/web", DependsOn: []string{"job/migrate"}},
+				{Resource: "job/migrate", DependsOn: []string{"statefulset/db"}},
+			},
+			expected: [][]string{{"service/web", "statefulset/db"}, {"job/migrate"}, {"deployment/web"}},
+		},
+		{
+			description: "diamond",
+			resources:   []string{"deployment/web", "deployment/api", "job/migrate", "statefulset/db"},
+			deps: []latest.DeployDependency{
+				{Resource: "deployment/web", DependsOn: []string{"deployment/api", "job/migrate"}},
+				{Resource: "deployment/api", DependsOn: []string{"statefulset/db"}},
+				{Resource: "job/migrate", DependsOn: []string{"statefulset/db"}},
+			},
+			expected: [][]string{{"statefulset/db"}, {"deployment/api", "job/migrate"}, {"deployment/web"}},
+		},
+		{
+			description: "ignore unknown resources",
+			resources:   []string{"deployment/web"},
+			deps:        []latest.DeployDependency{{Resource: "deployment/web", DependsOn: []string{"job/migrate"}}},
+			expected:    [][]string{{"deployment/web"}},
+		},
+		{
+			description: "cycle",
+			resources:   []string{"deployment/web", "job/migrate"},
+			deps: []latest.DeployDependency{
+				{Resource: "deployment/web", DependsOn: []string{"job/migrate"}},
+				{Resource: "job/migrate", DependsOn: []string{"statefulset/db"}},
+				{Resource: "statefulset/db", DependsOn: []string{"deployment/web"}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tiers, err := DependencyTiers(test.resources, test.deps)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, tiers)
+		})
+	}
+}
+
+func TestCheckDependencyCycles(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		err := CheckDependencyCycles([]latest.DeployDependency{
+			{Resource: "deployment/web", DependsOn: []string{"job/migrate"}},
+			{Resource: "job/migrate", DependsOn: []string{"deployment/web"}},
+		})
+
+		t.CheckErrorContains("cycle in deploy dependencies: deployment/web -> job/migrate -> deployment/web", err)
+	})
+}
+
+func TestApplyInTiers(t *testing.T) {
+	deps := []latest.DeployDependency{
+		{Resource: "deployment/web", DependsOn: []string{"job/migrate"}},
+		{Resource: "job/migrate", DependsOn: []string{"statefulset/db"}},
+	}
+
+	tests := []struct {
+		description string
+		commands    util.Command
+		shouldErr   bool
+	}{
+		{
+			description: "apply and wait tier by tier",
+			commands: testutil.
+				CmdRunInput("kubectl --context kubecontext apply -f -", dbManifest).
+				AndRun("kubectl --context kubecontext rollout status statefulset/db --timeout=1m0s").
+				AndRunInput("kubectl --context kubecontext apply -f -", migrateManifest).
+				AndRun("kubectl --context kubecontext --namespace jobs wait --for=condition=complete job/migrate --timeout=1m0s").
+				AndRunInput("kubectl --context kubecontext apply -f -", webManifest),
+		},
+		{
+			description: "stop when a dependency is not ready",
+			commands: testutil.
+				CmdRunInput("kubectl --context kubecontext apply -f -", dbManifest).
+				AndRunErr("kubectl --context kubecontext rollout status statefulset/db --timeout=1m0s", errors.New("timed out")),
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			event.InitializeState(latest.BuildConfig{})
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			cli := CLI{
+				CLI:          &pkgkubectl.CLI{KubeContext: "kubecontext"},
+				Dependencies: deps,
+				WaitTimeout:  time.Minute,
+			}
+			err := cli.Apply(context.Background(), ioutil.Discard, ManifestList{[]byte(webManifest), []byte(migrateManifest), []byte(dbManifest)})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
@@ -99,6 +99,22 @@ func TestKubectlDeploy(t *testing.T) {
 				Tag:       "leeroy-web:123",
 			}},
 		},
+		{
+			description: "server-side apply",
+			cfg: &latest.KubectlDeploy{
+				Manifests:       []string{"deployment.yaml"},
+				ServerSideApply: true,
+			},
+			commands: testutil.
+				CmdRunOut("kubectl version --client -ojson", kubectlVersion).
+				AndRunOut("kubectl --context kubecontext --namespace testNamespace create --dry-run -oyaml -f deployment.yaml", deploymentWebYAML).
+				AndRun("kubectl --context kubecontext --namespace testNamespace apply -f - --server-side --field-manager=skaffold"),
+			builds: []build.Artifact{{
+				ImageName: "leeroy-web",
+				Tag:       "leeroy-web:123",
+			}},
+			forceDeploy: true,
+		},
 		{
 			description: "http manifest",
 			cfg: &latest.KubectlDeploy{
@@ -180,6 +196,66 @@ func TestKubectlDeploy(t *testing.T) {
 	}
 }
 
+func TestKubectlDeployValidateOnly(t *testing.T) {
+	tests := []struct {
+		description string
+		commands    util.Command
+		expectedErr string
+	}{
+		{
+			description: "valid manifests",
+			commands: testutil.
+				CmdRunOut("kubectl version --client -ojson", kubectlVersion).
+				AndRunOut("kubectl --context kubecontext --namespace testNamespace create --dry-run -oyaml -f deployment.yaml", deploymentWebYAML+"\n---\n"+deploymentAppYAML).
+				AndRun("kubectl --context kubecontext --namespace testNamespace apply --dry-run=server -f -").
+				AndRun("kubectl --context kubecontext --namespace testNamespace apply --dry-run=server -f -"),
+		},
+		{
+			description: "validation errors are aggregated",
+			commands: testutil.
+				CmdRunOut("kubectl version --client -ojson", kubectlVersion).
+				AndRunOut("kubectl --context kubecontext --namespace testNamespace create --dry-run -oyaml -f deployment.yaml", deploymentWebYAML+"\n---\n"+deploymentAppYAML).
+				AndRun("kubectl --context kubecontext --namespace testNamespace apply --dry-run=server -f -").
+				AndRunErr("kubectl --context kubecontext --namespace testNamespace apply --dry-run=server -f -", errors.New("admission webhook denied the request")),
+			expectedErr: "1/2 manifest(s) failed validation:\n - pod/leeroy-app: admission webhook denied the request",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			t.NewTempDir().
+				Write("deployment.yaml", deploymentWebYAML+"\n---\n"+deploymentAppYAML).
+				Chdir()
+
+			k := NewKubectlDeployer(&runcontext.RunContext{
+				WorkingDir: ".",
+				Cfg: latest.Pipeline{
+					Deploy: latest.DeployConfig{
+						DeployType: latest.DeployType{
+							KubectlDeploy: &latest.KubectlDeploy{
+								Manifests: []string{"deployment.yaml"},
+							},
+						},
+					},
+				},
+				KubeContext: testKubeContext,
+				Opts: config.SkaffoldOptions{
+					Namespace:    testNamespace,
+					ValidateOnly: true,
+				},
+			})
+
+			err := k.Deploy(context.Background(), ioutil.Discard, nil, nil).GetError()
+
+			if test.expectedErr != "" {
+				t.CheckErrorContains(test.expectedErr, err)
+			} else {
+				t.CheckNoError(err)
+			}
+		})
+	}
+}
+
 func TestKubectlCleanup(t *testing.T) {
 	tests := []struct {
 		description string
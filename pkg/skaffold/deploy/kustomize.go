@@ -25,6 +25,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	yaml "gopkg.in/yaml.v2"
@@ -72,23 +73,42 @@ type KustomizeDeployer struct {
 	kubectl            deploy.CLI
 	defaultRepo        string
 	insecureRegistries map[string]bool
+	selectManifests    bool
 	BuildArgs          []string
 }
 
 func NewKustomizeDeployer(runCtx *runcontext.RunContext) *KustomizeDeployer {
+	kustomizeDeploy := *runCtx.Cfg.Deploy.KustomizeDeploy
+	kustomizeDeploy.KustomizePath = overlayPath(&kustomizeDeploy, runCtx.Opts.Profiles)
+
 	return &KustomizeDeployer{
-		KustomizeDeploy: runCtx.Cfg.Deploy.KustomizeDeploy,
+		KustomizeDeploy: &kustomizeDeploy,
 		kubectl: deploy.CLI{
-			CLI:         kubectl.NewFromRunContext(runCtx),
-			Flags:       runCtx.Cfg.Deploy.KustomizeDeploy.Flags,
-			ForceDeploy: runCtx.Opts.ForceDeploy(),
+			CLI:          kubectl.NewFromRunContext(runCtx),
+			Flags:        runCtx.Cfg.Deploy.KustomizeDeploy.Flags,
+			ForceDeploy:  runCtx.Opts.ForceDeploy(),
+			ValidateOnly: runCtx.Opts.ValidateOnly,
+			Dependencies: runCtx.Cfg.Deploy.Dependencies,
+			WaitTimeout:  getDeadline(runCtx.Cfg.Deploy.StatusCheckDeadlineSeconds),
 		},
 		defaultRepo:        runCtx.DefaultRepo,
 		insecureRegistries: runCtx.InsecureRegistries,
+		selectManifests:    len(runCtx.Opts.Only) > 0,
 		BuildArgs:          runCtx.Cfg.Deploy.KustomizeDeploy.BuildArgs,
 	}
 }
 
+// overlayPath returns the path to the overlay of the last activated
+// profile that has one, or the configured path if none of them has.
+func overlayPath(kustomizeDeploy *latest.KustomizeDeploy, profiles []string) string {
+	for i := len(profiles) - 1; i >= 0; i-- {
+		if path, found := kustomizeDeploy.Overlays[profiles[i]]; found {
+			return path
+		}
+	}
+	return kustomizeDeploy.KustomizePath
+}
+
 // Labels returns the labels specific to kustomize.
 func (k *KustomizeDeployer) Labels() map[string]string {
 	return map[string]string{
@@ -103,6 +123,7 @@ func (k *KustomizeDeployer) Deploy(ctx context.Context, out io.Writer, builds []
 		color.Default.Fprintln(out, err)
 	}
 
+	start := time.Now()
 	manifests, err := k.readManifests(ctx)
 	if err != nil {
 		event.DeployFailed(err)
@@ -126,6 +147,14 @@ func (k *KustomizeDeployer) Deploy(ctx context.Context, out io.Writer, builds []
 		return NewDeployErrorResult(errors.Wrap(err, "replacing images in manifests"))
 	}
 
+	if k.selectManifests {
+		manifests, err = manifests.SelectImages(artifactImageNames(builds))
+		if err != nil {
+			event.DeployFailed(err)
+			return NewDeployErrorResult(errors.Wrap(err, "selecting manifests"))
+		}
+	}
+
 	manifests, err = manifests.SetLabels(merge(labellers...))
 	if err != nil {
 		event.DeployFailed(err)
@@ -139,14 +168,27 @@ func (k *KustomizeDeployer) Deploy(ctx context.Context, out io.Writer, builds []
 			return NewDeployErrorResult(errors.Wrap(err, "unable to transform manifests"))
 		}
 	}
+	event.DeployPhaseCompleted(event.RenderPhase, time.Since(start))
 
+	applyStart := time.Now()
 	if err := k.kubectl.Apply(ctx, textio.NewPrefixWriter(out, " - "), manifests); err != nil {
 		event.DeployFailed(err)
 		return NewDeployErrorResult(errors.Wrap(err, "kubectl error"))
 	}
+	event.DeployPhaseCompleted(event.ApplyPhase, time.Since(applyStart))
+
+	if k.kubectl.ValidateOnly {
+		event.DeployValidated()
+		return NewDeploySuccessResult(namespaces)
+	}
 
 	event.DeployComplete()
-	return NewDeploySuccessResult(namespaces)
+	return NewDeploySuccessResult(namespaces).WithResources(manifestResources(manifests))
+}
+
+// Rollback rolls back the Deployments of the current run to their previous revision.
+func (k *KustomizeDeployer) Rollback(ctx context.Context, out io.Writer, defaultLabeller *DefaultLabeller) ([]string, error) {
+	return rollbackDeployments(ctx, out, k.kubectl.CLI, defaultLabeller)
 }
 
 // Cleanup deletes what was deployed by calling Deploy.
@@ -420,3 +420,56 @@ func TestKustomizeRender(t *testing.T) {
 		})
 	}
 }
+
+func TestOverlayPath(t *testing.T) {
+	tests := []struct {
+		description string
+		profiles    []string
+		expected    string
+	}{
+		{
+			description: "no profile",
+			expected:    "base",
+		},
+		{
+			description: "profile with an overlay",
+			profiles:    []string{"prod"},
+			expected:    "overlays/prod",
+		},
+		{
+			description: "profile without an overlay",
+			profiles:    []string{"local"},
+			expected:    "base",
+		},
+		{
+			description: "last profile with an overlay wins",
+			profiles:    []string{"prod", "dev", "local"},
+			expected:    "overlays/dev",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			kustomizeDeploy := &latest.KustomizeDeploy{
+				KustomizePath: "base",
+				Overlays: map[string]string{
+					"dev":  "overlays/dev",
+					"prod": "overlays/prod",
+				},
+			}
+
+			deployer := NewKustomizeDeployer(&runcontext.RunContext{
+				Cfg: latest.Pipeline{
+					Deploy: latest.DeployConfig{
+						DeployType: latest.DeployType{
+							KustomizeDeploy: kustomizeDeploy,
+						},
+					},
+				},
+				Opts: config.SkaffoldOptions{Profiles: test.profiles},
+			})
+
+			t.CheckDeepEqual(test.expected, deployer.KustomizePath)
+			t.CheckDeepEqual("base", kustomizeDeploy.KustomizePath)
+		})
+	}
+}
@@ -59,6 +59,13 @@ func NewLabeller(verStr string) *DefaultLabeller {
 	}
 }
 
+// StaticLabeller adds a fixed set of labels, given by the user.
+type StaticLabeller map[string]string
+
+func (l StaticLabeller) Labels() map[string]string {
+	return l
+}
+
 func (d *DefaultLabeller) Labels() map[string]string {
 	return map[string]string{
 		K8sManagedByLabelKey: d.skaffoldVersion(),
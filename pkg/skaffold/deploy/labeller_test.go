@@ -74,3 +74,22 @@ func TestK8sManagedByLabelKeyValueString(t *testing.T) {
 		t.Fatalf("actual label not equal to expected label. Actual: \n %s \n Expected: \n %s", actual, expected)
 	}
 }
+
+func TestStaticLabellerDoesntOverrideSkaffoldLabels(t *testing.T) {
+	static := StaticLabeller{
+		"git-sha":            "abcdef",
+		K8sManagedByLabelKey: "ci",
+	}
+	defaultLabeller := &DefaultLabeller{
+		version: "version",
+		runID:   "run-id",
+	}
+
+	labels := merge(static, defaultLabeller)
+
+	testutil.CheckDeepEqual(t, map[string]string{
+		"git-sha":            "abcdef",
+		K8sManagedByLabelKey: "skaffold-version",
+		RunIDLabel:           "run-id",
+	}, labels)
+}
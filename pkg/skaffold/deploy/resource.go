@@ -29,6 +29,9 @@ type Resource interface {
 	// Name returns resource Name
 	Name() string
 
+	// Namespace returns resource Namespace
+	Namespace() string
+
 	// Status returns resource status
 	Status() resource.Status
 
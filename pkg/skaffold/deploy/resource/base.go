@@ -17,7 +17,12 @@ limitations under the License.
 package resource
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 )
 
 type Base struct {
@@ -36,6 +41,10 @@ func (b *Base) Name() string {
 	return b.name
 }
 
+func (b *Base) Namespace() string {
+	return b.namespace
+}
+
 func (b *Base) Status() Status {
 	return b.status
 }
@@ -51,3 +60,17 @@ func (b *Base) ReportSinceLastUpdated() string {
 	b.status.reported = true
 	return fmt.Sprintf("%s %s", b, b.status)
 }
+
+// getJSON fetches a resource with `kubectl get` and decodes it into `into`.
+func getJSON(ctx context.Context, runCtx *runcontext.RunContext, rType string, name string, namespace string, into interface{}) error {
+	kubeCtl := kubectl.NewFromRunContext(runCtx)
+	b, err := kubeCtl.RunOut(ctx, "get", rType, name, "--namespace", namespace, "-ojson")
+	if err != nil {
+		return parseKubectlRolloutError(err)
+	}
+
+	if err := json.Unmarshal(b, into); err != nil {
+		return fmt.Errorf("reading %s status: %s", rType, err)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+)
+
+const (
+	daemonSetType = "daemonset"
+)
+
+type DaemonSet struct {
+	*Base
+	deadline time.Duration
+}
+
+func NewDaemonSet(name string, ns string, deadline time.Duration) *DaemonSet {
+	return &DaemonSet{
+		Base: &Base{
+			name:      name,
+			namespace: ns,
+			rType:     daemonSetType,
+			status:    newStatus("", nil),
+		},
+		deadline: deadline,
+	}
+}
+
+func (d *DaemonSet) Deadline() time.Duration {
+	return d.deadline
+}
+
+func (d *DaemonSet) UpdateStatus(details string, err error) {
+	updated := newStatus(details, err)
+	if !d.status.Equal(updated) {
+		d.status = updated
+		if isErrAndNotRetryAble(err) {
+			d.done = true
+		}
+	}
+}
+
+func (d *DaemonSet) CheckStatus(ctx context.Context, runCtx *runcontext.RunContext) {
+	var daemonSet appsv1.DaemonSet
+	if err := getJSON(ctx, runCtx, daemonSetType, d.name, d.namespace, &daemonSet); err != nil {
+		d.UpdateStatus("", err)
+		return
+	}
+
+	details, ready := daemonSetStatus(daemonSet)
+	d.UpdateStatus(details, nil)
+	d.done = ready
+}
+
+func daemonSetStatus(d appsv1.DaemonSet) (string, bool) {
+	if d.Status.ObservedGeneration < d.Generation {
+		return "waiting for the daemonset spec update to be observed", false
+	}
+
+	desired := d.Status.DesiredNumberScheduled
+	if d.Status.UpdatedNumberScheduled < desired {
+		return fmt.Sprintf("waiting for the rollout to complete: %d/%d pods updated", d.Status.UpdatedNumberScheduled, desired), false
+	}
+	if d.Status.NumberReady < desired {
+		return fmt.Sprintf("waiting for pods to be ready: %d/%d ready", d.Status.NumberReady, desired), false
+	}
+
+	return fmt.Sprintf("%d/%d pods ready", d.Status.NumberReady, desired), true
+}
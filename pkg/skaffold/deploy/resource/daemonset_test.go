@@ -0,0 +1,86 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestDaemonSetCheckStatus(t *testing.T) {
+	getCmd := "kubectl --context kubecontext get daemonset agent --namespace test -ojson"
+	tests := []struct {
+		description     string
+		commands        util.Command
+		expectedErr     string
+		expectedDetails string
+		complete        bool
+	}{
+		{
+			description: "all pods ready",
+			commands: testutil.CmdRunOut(getCmd, `{"metadata": {"generation": 2},
+				"status": {"observedGeneration": 2, "desiredNumberScheduled": 3, "updatedNumberScheduled": 3, "numberReady": 3}}`),
+			expectedDetails: "3/3 pods ready",
+			complete:        true,
+		},
+		{
+			description: "spec update not observed",
+			commands: testutil.CmdRunOut(getCmd, `{"metadata": {"generation": 2},
+				"status": {"observedGeneration": 1, "desiredNumberScheduled": 3, "updatedNumberScheduled": 3, "numberReady": 3}}`),
+			expectedDetails: "waiting for the daemonset spec update to be observed",
+		},
+		{
+			description:     "rollout in progress",
+			commands:        testutil.CmdRunOut(getCmd, `{"status": {"desiredNumberScheduled": 3, "updatedNumberScheduled": 1, "numberReady": 3}}`),
+			expectedDetails: "waiting for the rollout to complete: 1/3 pods updated",
+		},
+		{
+			description:     "pods not ready",
+			commands:        testutil.CmdRunOut(getCmd, `{"status": {"desiredNumberScheduled": 3, "updatedNumberScheduled": 3, "numberReady": 2}}`),
+			expectedDetails: "waiting for pods to be ready: 2/3 ready",
+		},
+		{
+			description: "kubectl error",
+			commands:    testutil.CmdRunOutErr(getCmd, "", errors.New("not found")),
+			expectedErr: "not found",
+			complete:    true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			r := NewDaemonSet("agent", "test", 0)
+			runCtx := &runcontext.RunContext{
+				KubeContext: "kubecontext",
+			}
+
+			r.CheckStatus(context.Background(), runCtx)
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckComplete())
+			if test.expectedErr != "" {
+				t.CheckErrorContains(test.expectedErr, r.Status().Error())
+			} else {
+				t.CheckDeepEqual(test.expectedDetails, r.status.details)
+			}
+		})
+	}
+}
@@ -31,11 +31,13 @@ const (
 	rollOutSuccess   = "successfully rolled out"
 	connectionErrMsg = "Unable to connect to the server"
 	killedErrMsg     = "signal: killed"
+	rateLimitedMsg   = "the server has received too many requests"
 )
 
 var (
 	errKubectlKilled     = errors.New("kubectl rollout status command killed")
 	ErrKubectlConnection = errors.New("kubectl connection error")
+	ErrRateLimited       = errors.New("rate limited by the kubernetes API server")
 )
 
 type Deployment struct {
@@ -87,6 +89,9 @@ func parseKubectlRolloutError(err error) error {
 	if strings.Contains(err.Error(), killedErrMsg) {
 		return errKubectlKilled
 	}
+	if strings.Contains(err.Error(), rateLimitedMsg) {
+		return ErrRateLimited
+	}
 	return err
 }
 
@@ -94,5 +99,5 @@ func isErrAndNotRetryAble(err error) bool {
 	if err == nil {
 		return false
 	}
-	return err != ErrKubectlConnection
+	return err != ErrKubectlConnection && err != ErrRateLimited
 }
@@ -118,6 +118,12 @@ func TestParseKubectlError(t *testing.T) {
 			expected:    errKubectlKilled.Error(),
 			shouldErr:   true,
 		},
+		{
+			description: "rollout status rate limited",
+			err:         errors.New("Error from server (TooManyRequests): the server has received too many requests and has asked us to try again later"),
+			expected:    ErrRateLimited.Error(),
+			shouldErr:   true,
+		},
 		{
 			description: "rollout status random error",
 			err:         errors.New("deployment test not found"),
@@ -0,0 +1,118 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+)
+
+const (
+	hpaType = "hpa"
+)
+
+// HorizontalPodAutoscaler is ready once it knows the current number of replicas
+// of the resource it scales.
+type HorizontalPodAutoscaler struct {
+	*Base
+	deadline time.Duration
+
+	// scalingLimited is set while every check has found the autoscaler
+	// limited in its ability to scale.
+	scalingLimited string
+	checked        bool
+}
+
+func NewHorizontalPodAutoscaler(name string, ns string, deadline time.Duration) *HorizontalPodAutoscaler {
+	return &HorizontalPodAutoscaler{
+		Base: &Base{
+			name:      name,
+			namespace: ns,
+			rType:     hpaType,
+			status:    newStatus("", nil),
+		},
+		deadline: deadline,
+	}
+}
+
+func (h *HorizontalPodAutoscaler) Deadline() time.Duration {
+	return h.deadline
+}
+
+func (h *HorizontalPodAutoscaler) UpdateStatus(details string, err error) {
+	// Report that the autoscaler was limited rather than a generic timeout.
+	if err == context.DeadlineExceeded && h.scalingLimited != "" {
+		err = fmt.Errorf("scaling limited for the whole status check: %s", h.scalingLimited)
+	}
+
+	updated := newStatus(details, err)
+	if !h.status.Equal(updated) {
+		h.status = updated
+		if isErrAndNotRetryAble(err) {
+			h.done = true
+		}
+	}
+}
+
+func (h *HorizontalPodAutoscaler) CheckStatus(ctx context.Context, runCtx *runcontext.RunContext) {
+	var hpa autoscalingv2beta2.HorizontalPodAutoscaler
+	if err := getJSON(ctx, runCtx, "hpa.v2beta2.autoscaling", h.name, h.namespace, &hpa); err != nil {
+		h.UpdateStatus("", err)
+		return
+	}
+
+	h.checkConditions(hpa)
+}
+
+func (h *HorizontalPodAutoscaler) checkConditions(hpa autoscalingv2beta2.HorizontalPodAutoscaler) {
+	var limitedReason string
+	if limited := condition(hpa, autoscalingv2beta2.ScalingLimited); limited != nil && limited.Status == v1.ConditionTrue {
+		limitedReason = fmt.Sprintf("%s: %s", limited.Reason, limited.Message)
+	}
+	if !h.checked || h.scalingLimited != "" {
+		h.scalingLimited = limitedReason
+	}
+	h.checked = true
+
+	active := condition(hpa, autoscalingv2beta2.ScalingActive)
+	if active != nil && active.Status == v1.ConditionTrue && hpa.Status.CurrentReplicas > 0 {
+		h.UpdateStatus(fmt.Sprintf("scaling active with %d current replica(s)", hpa.Status.CurrentReplicas), nil)
+		h.done = true
+		return
+	}
+
+	if limitedReason != "" {
+		h.UpdateStatus(fmt.Sprintf("scaling limited: %s", limitedReason), nil)
+		return
+	}
+	h.UpdateStatus("waiting for the current replica count to be known", nil)
+}
+
+func condition(hpa autoscalingv2beta2.HorizontalPodAutoscaler, conditionType autoscalingv2beta2.HorizontalPodAutoscalerConditionType) *autoscalingv2beta2.HorizontalPodAutoscalerCondition {
+	for i := range hpa.Status.Conditions {
+		if hpa.Status.Conditions[i].Type == conditionType {
+			return &hpa.Status.Conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestHorizontalPodAutoscalerCheckStatus(t *testing.T) {
+	getCmd := "kubectl --context kubecontext get hpa.v2beta2.autoscaling hpa --namespace test -ojson"
+	tests := []struct {
+		description     string
+		commands        util.Command
+		expectedErr     string
+		expectedDetails string
+		complete        bool
+	}{
+		{
+			description: "scaling active",
+			commands: testutil.CmdRunOut(getCmd, `{"status": {"currentReplicas": 2, "conditions": [
+				{"type": "ScalingActive", "status": "True"}
+			]}}`),
+			expectedDetails: "scaling active with 2 current replica(s)",
+			complete:        true,
+		},
+		{
+			description: "unknown replica count",
+			commands: testutil.CmdRunOut(getCmd, `{"status": {"conditions": [
+				{"type": "ScalingActive", "status": "False", "reason": "FailedGetResourceMetric"}
+			]}}`),
+			expectedDetails: "waiting for the current replica count to be known",
+		},
+		{
+			description: "scaling limited",
+			commands: testutil.CmdRunOut(getCmd, `{"status": {"conditions": [
+				{"type": "ScalingLimited", "status": "True", "reason": "TooFewReplicas", "message": "the desired replica count is less than the minimum replica count"}
+			]}}`),
+			expectedDetails: "scaling limited: TooFewReplicas: the desired replica count is less than the minimum replica count",
+		},
+		{
+			description: "kubectl error",
+			commands:    testutil.CmdRunOutErr(getCmd, "", errors.New("not found")),
+			expectedErr: "not found",
+			complete:    true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			r := NewHorizontalPodAutoscaler("hpa", "test", 0)
+			runCtx := &runcontext.RunContext{
+				KubeContext: "kubecontext",
+			}
+
+			r.CheckStatus(context.Background(), runCtx)
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckComplete())
+			if test.expectedErr != "" {
+				t.CheckErrorContains(test.expectedErr, r.Status().Error())
+			} else {
+				t.CheckDeepEqual(test.expectedDetails, r.status.details)
+			}
+		})
+	}
+}
+
+func TestHorizontalPodAutoscalerScalingLimitedTimeout(t *testing.T) {
+	getCmd := "kubectl --context kubecontext get hpa.v2beta2.autoscaling hpa --namespace test -ojson"
+	limited := `{"status": {"conditions": [{"type": "ScalingLimited", "status": "True", "reason": "TooManyReplicas", "message": "max replicas reached"}]}}`
+	unknown := `{"status": {}}`
+
+	tests := []struct {
+		description string
+		commands    util.Command
+		expectedErr string
+	}{
+		{
+			description: "limited for the whole window",
+			commands:    testutil.CmdRunOut(getCmd, limited).AndRunOut(getCmd, limited),
+			expectedErr: "scaling limited for the whole status check: TooManyReplicas: max replicas reached",
+		},
+		{
+			description: "limited only part of the window",
+			commands:    testutil.CmdRunOut(getCmd, unknown).AndRunOut(getCmd, limited),
+			expectedErr: context.DeadlineExceeded.Error(),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			r := NewHorizontalPodAutoscaler("hpa", "test", 0)
+			runCtx := &runcontext.RunContext{
+				KubeContext: "kubecontext",
+			}
+
+			r.CheckStatus(context.Background(), runCtx)
+			r.CheckStatus(context.Background(), runCtx)
+			r.UpdateStatus(context.DeadlineExceeded.Error(), context.DeadlineExceeded)
+
+			t.CheckErrorContains(test.expectedErr, r.Status().Error())
+		})
+	}
+}
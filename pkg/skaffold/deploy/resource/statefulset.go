@@ -0,0 +1,94 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+)
+
+const (
+	statefulSetType = "statefulset"
+)
+
+type StatefulSet struct {
+	*Base
+	deadline time.Duration
+}
+
+func NewStatefulSet(name string, ns string, deadline time.Duration) *StatefulSet {
+	return &StatefulSet{
+		Base: &Base{
+			name:      name,
+			namespace: ns,
+			rType:     statefulSetType,
+			status:    newStatus("", nil),
+		},
+		deadline: deadline,
+	}
+}
+
+func (s *StatefulSet) Deadline() time.Duration {
+	return s.deadline
+}
+
+func (s *StatefulSet) UpdateStatus(details string, err error) {
+	updated := newStatus(details, err)
+	if !s.status.Equal(updated) {
+		s.status = updated
+		if isErrAndNotRetryAble(err) {
+			s.done = true
+		}
+	}
+}
+
+func (s *StatefulSet) CheckStatus(ctx context.Context, runCtx *runcontext.RunContext) {
+	var statefulSet appsv1.StatefulSet
+	if err := getJSON(ctx, runCtx, statefulSetType, s.name, s.namespace, &statefulSet); err != nil {
+		s.UpdateStatus("", err)
+		return
+	}
+
+	details, ready := statefulSetStatus(statefulSet)
+	s.UpdateStatus(details, nil)
+	s.done = ready
+}
+
+func statefulSetStatus(s appsv1.StatefulSet) (string, bool) {
+	if s.Status.ObservedGeneration < s.Generation {
+		return "waiting for the statefulset spec update to be observed", false
+	}
+
+	replicas := int32(1)
+	if s.Spec.Replicas != nil {
+		replicas = *s.Spec.Replicas
+	}
+	if s.Status.ReadyReplicas < replicas {
+		return fmt.Sprintf("waiting for replicas to be ready: %d/%d ready", s.Status.ReadyReplicas, replicas), false
+	}
+
+	if s.Spec.UpdateStrategy.Type == appsv1.RollingUpdateStatefulSetStrategyType && s.Status.UpdateRevision != s.Status.CurrentRevision {
+		return fmt.Sprintf("waiting for the rolling update to complete: %d/%d replicas updated", s.Status.UpdatedReplicas, replicas), false
+	}
+
+	return fmt.Sprintf("%d/%d replicas ready", s.Status.ReadyReplicas, replicas), true
+}
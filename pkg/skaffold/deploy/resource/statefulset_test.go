@@ -0,0 +1,100 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestStatefulSetCheckStatus(t *testing.T) {
+	getCmd := "kubectl --context kubecontext get statefulset web --namespace test -ojson"
+	tests := []struct {
+		description     string
+		commands        util.Command
+		expectedErr     string
+		expectedDetails string
+		complete        bool
+	}{
+		{
+			description: "all replicas ready",
+			commands: testutil.CmdRunOut(getCmd, `{"metadata": {"generation": 2}, "spec": {"replicas": 3},
+				"status": {"observedGeneration": 2, "readyReplicas": 3}}`),
+			expectedDetails: "3/3 replicas ready",
+			complete:        true,
+		},
+		{
+			description:     "defaults to one replica",
+			commands:        testutil.CmdRunOut(getCmd, `{"status": {"readyReplicas": 1}}`),
+			expectedDetails: "1/1 replicas ready",
+			complete:        true,
+		},
+		{
+			description: "spec update not observed",
+			commands: testutil.CmdRunOut(getCmd, `{"metadata": {"generation": 2}, "spec": {"replicas": 3},
+				"status": {"observedGeneration": 1, "readyReplicas": 3}}`),
+			expectedDetails: "waiting for the statefulset spec update to be observed",
+		},
+		{
+			description:     "replicas not ready",
+			commands:        testutil.CmdRunOut(getCmd, `{"spec": {"replicas": 3}, "status": {"readyReplicas": 1}}`),
+			expectedDetails: "waiting for replicas to be ready: 1/3 ready",
+		},
+		{
+			description: "rolling update in progress",
+			commands: testutil.CmdRunOut(getCmd, `{"spec": {"replicas": 2, "updateStrategy": {"type": "RollingUpdate"}},
+				"status": {"readyReplicas": 2, "updatedReplicas": 1, "currentRevision": "web-1", "updateRevision": "web-2"}}`),
+			expectedDetails: "waiting for the rolling update to complete: 1/2 replicas updated",
+		},
+		{
+			description: "on delete strategy ignores revisions",
+			commands: testutil.CmdRunOut(getCmd, `{"spec": {"replicas": 2, "updateStrategy": {"type": "OnDelete"}},
+				"status": {"readyReplicas": 2, "currentRevision": "web-1", "updateRevision": "web-2"}}`),
+			expectedDetails: "2/2 replicas ready",
+			complete:        true,
+		},
+		{
+			description: "kubectl error",
+			commands:    testutil.CmdRunOutErr(getCmd, "", errors.New("not found")),
+			expectedErr: "not found",
+			complete:    true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			r := NewStatefulSet("web", "test", 0)
+			runCtx := &runcontext.RunContext{
+				KubeContext: "kubecontext",
+			}
+
+			r.CheckStatus(context.Background(), runCtx)
+			t.CheckDeepEqual(test.complete, r.IsStatusCheckComplete())
+			if test.expectedErr != "" {
+				t.CheckErrorContains(test.expectedErr, r.Status().Error())
+			} else {
+				t.CheckDeepEqual(test.expectedDetails, r.status.details)
+			}
+		})
+	}
+}
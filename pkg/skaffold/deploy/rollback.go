@@ -0,0 +1,75 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	pkgkubernetes "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+)
+
+// revisionAnnotation is set by kubernetes on each Deployment to its current revision.
+const revisionAnnotation = "deployment.kubernetes.io/revision"
+
+// Rollbacker is implemented by deployers that can roll back
+// the Deployments of the current run to their previous revision.
+type Rollbacker interface {
+	// Rollback returns the list of resources that were rolled back.
+	Rollback(context.Context, io.Writer, *DefaultLabeller) ([]string, error)
+}
+
+// rollbackDeployments runs `kubectl rollout undo` on each Deployment of the current run
+// that has a previous revision.
+func rollbackDeployments(ctx context.Context, out io.Writer, cli *kubectl.CLI, defaultLabeller *DefaultLabeller) ([]string, error) {
+	client, err := pkgkubernetes.Client()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting kubernetes client")
+	}
+
+	deps, err := client.AppsV1().Deployments(cli.Namespace).List(metav1.ListOptions{
+		LabelSelector: defaultLabeller.RunIDKeyValueString(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch deployments")
+	}
+
+	var rolledBack []string
+	for _, d := range deps.Items {
+		resource := fmt.Sprintf("%s:deployment/%s", d.Namespace, d.Name)
+
+		revision, err := strconv.Atoi(d.Annotations[revisionAnnotation])
+		if err != nil || revision <= 1 {
+			logrus.Infof("not rolling back %s: previous revision is unknown", resource)
+			continue
+		}
+
+		if err := cli.RunInNamespace(ctx, nil, out, "rollout", d.Namespace, "undo", "deployment/"+d.Name); err != nil {
+			return rolledBack, errors.Wrapf(err, "rolling back %s", resource)
+		}
+		rolledBack = append(rolledBack, resource)
+	}
+
+	return rolledBack, nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	pkgkubernetes "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestRollbackDeployments(t *testing.T) {
+	labeller := NewLabeller("")
+	deployment := func(name string, revision string, runID string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "test",
+				Labels:      map[string]string{RunIDLabel: runID},
+				Annotations: map[string]string{revisionAnnotation: revision},
+			},
+		}
+	}
+
+	tests := []struct {
+		description string
+		deps        []*appsv1.Deployment
+		commands    util.Command
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "roll back deployments with a previous revision",
+			deps: []*appsv1.Deployment{
+				deployment("dep1", "2", labeller.runID),
+				deployment("dep2", "1", labeller.runID),
+				deployment("dep3", "3", "other-run"),
+			},
+			commands: testutil.CmdRun("kubectl --context kubecontext --namespace test rollout undo deployment/dep1"),
+			expected: []string{"test:deployment/dep1"},
+		},
+		{
+			description: "rollback error",
+			deps: []*appsv1.Deployment{
+				deployment("dep1", "2", labeller.runID),
+				deployment("dep2", "5", labeller.runID),
+			},
+			commands: testutil.
+				CmdRun("kubectl --context kubecontext --namespace test rollout undo deployment/dep1").
+				AndRunErr("kubectl --context kubecontext --namespace test rollout undo deployment/dep2", errors.New("BUG")),
+			expected:  []string{"test:deployment/dep1"},
+			shouldErr: true,
+		},
+		{
+			description: "no previous revision",
+			deps: []*appsv1.Deployment{
+				deployment("dep1", "", labeller.runID),
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			objs := make([]runtime.Object, len(test.deps))
+			for i, dep := range test.deps {
+				objs[i] = dep
+			}
+			t.Override(&pkgkubernetes.Client, func() (kubernetes.Interface, error) {
+				return fakekubeclientset.NewSimpleClientset(objs...), nil
+			})
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			cli := &kubectl.CLI{KubeContext: "kubecontext", Namespace: "test"}
+			rolledBack, err := rollbackDeployments(context.Background(), ioutil.Discard, cli, labeller)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, rolledBack)
+		})
+	}
+}
@@ -20,20 +20,28 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/resource"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	pkgkubernetes "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/proto"
 )
 
 var (
@@ -48,48 +56,187 @@ var (
 
 const (
 	tabHeader = " -"
+
+	// Bounds of the interval between two checks of the status of a resource.
+	// Shorter intervals put too much pressure on the API server and longer ones
+	// delay the feedback too much.
+	minStatusCheckPollInterval = 50 * time.Millisecond
+	maxStatusCheckPollInterval = 10 * time.Second
+
+	// StatusCheckTimeoutAnnotation overrides the status check deadline for a single resource.
+	// Its value is either a duration (eg. `5m`) or a number of seconds.
+	StatusCheckTimeoutAnnotation = "skaffold.dev/status-check-timeout"
+
+	// StatusCheckAnnotation excludes a resource from the status check when set to `false`.
+	// The resource is still deployed but isn't waited for.
+	StatusCheckAnnotation = "skaffold.dev/status-check"
 )
 
 type counter struct {
 	total   int
 	pending int32
 	failed  int32
+	ready   int32
 }
 
-func StatusCheck(ctx context.Context, defaultLabeller *DefaultLabeller, runCtx *runcontext.RunContext, out io.Writer) error {
-	client, err := pkgkubernetes.Client()
+// StatusCheck waits for the deployed workloads to stabilize. When the deployer
+// reported the resources it applied, exactly those are checked. Otherwise, the
+// workloads are found with Skaffold's run-id label.
+func StatusCheck(ctx context.Context, defaultLabeller *DefaultLabeller, applied []AppliedResource, runCtx *runcontext.RunContext, out io.Writer) error {
+	client, err := kubernetesClient(runCtx)
 	if err != nil {
 		return errors.Wrap(err, "getting kubernetes client")
 	}
 
 	deadline := getDeadline(runCtx.Cfg.Deploy.StatusCheckDeadlineSeconds)
-	deployments, err := getDeployments(client, runCtx.Opts.Namespace, defaultLabeller, deadline)
-	if err != nil {
-		return errors.Wrap(err, "could not fetch deployments")
+	var deployments, workloads []Resource
+	if len(applied) > 0 {
+		deployments, workloads, err = getAppliedWorkloads(client, runCtx, applied, deadline)
+		if err != nil {
+			return errors.Wrap(err, "could not fetch deployed resources")
+		}
+	} else {
+		deployments, err = getDeployments(client, runCtx.Opts.Namespace, defaultLabeller, deadline)
+		if err != nil {
+			return errors.Wrap(err, "could not fetch deployments")
+		}
+
+		statefulSets, err := getStatefulSets(client, runCtx.Opts.Namespace, defaultLabeller, deadline)
+		if err != nil {
+			return errors.Wrap(err, "could not fetch statefulsets")
+		}
+
+		daemonSets, err := getDaemonSets(client, runCtx.Opts.Namespace, defaultLabeller, deadline)
+		if err != nil {
+			return errors.Wrap(err, "could not fetch daemonsets")
+		}
+
+		workloads = append(statefulSets, daemonSets...)
+	}
+
+	resources := append([]Resource{}, deployments...)
+	resources = append(resources, workloads...)
+	if runCtx.Opts.StatusCheckHPAs {
+		hpas, err := getHPAs(client, runCtx.Opts.Namespace, deployments, deadline)
+		if err != nil {
+			return errors.Wrap(err, "could not fetch horizontal pod autoscalers")
+		}
+		resources = append(resources, hpas...)
+	}
+
+	// With JSON output, only the summary is printed.
+	textOut := out
+	if runCtx.Opts.JSONOutput {
+		textOut = ioutil.Discard
+	}
+
+	resources, skipped := withoutSkippedResources(resources)
+	for _, r := range skipped {
+		color.Default.Fprintln(textOut, tabHeader, r, "is excluded from the status check")
 	}
 
 	wg := sync.WaitGroup{}
 
-	c := newCounter(len(deployments))
+	c := newCounter(len(resources))
+	event.StatusCheckEventInProgress(0, c.total)
+	pollInterval := statusCheckPollInterval(runCtx.Opts.StatusCheckPollInterval)
+	start := time.Now()
+	summaries := make([]*proto.ResourceStatusCheckSummary, len(resources))
 
-	for _, d := range deployments {
+	for i, d := range resources {
 		wg.Add(1)
-		go func(r Resource) {
+		go func(i int, r Resource) {
 			defer wg.Done()
-			pollResourceStatus(ctx, runCtx, r)
+			pollResourceStatus(ctx, runCtx, r, pollInterval)
+			summaries[i] = resourceSummary(runCtx, r, time.Since(start))
+			switch err := r.Status().Error(); {
+			case err == context.Canceled:
+				// Interrupted checks are reported once for the whole status check.
+			case err != nil:
+				event.ResourceStatusCheckEventFailed(eventResourceName(runCtx, r), err)
+			default:
+				event.ResourceStatusCheckEventSucceeded(eventResourceName(runCtx, r))
+			}
 			pending := c.markProcessed(r.Status().Error())
-			printStatusCheckSummary(out, r, pending, c.total)
-		}(d)
+			if r.Status().Error() == nil {
+				event.StatusCheckEventInProgress(c.readyCount(), c.total)
+			}
+			printStatusCheckSummary(textOut, r, pending, c.total)
+		}(i, d)
 	}
 
 	// Retrieve pending resource states
 	go func() {
-		printResourceStatus(ctx, out, deployments, deadline)
+		printResourceStatus(ctx, textOut, runCtx, resources, maxDeadline(resources, deadline))
 	}()
 
 	// Wait for all deployment status to be fetched
 	wg.Wait()
-	return getSkaffoldDeployStatus(c)
+
+	for _, r := range skipped {
+		summaries = append(summaries, &proto.ResourceStatusCheckSummary{
+			Resource: eventResourceName(runCtx, r),
+			Status:   event.Skipped,
+		})
+	}
+
+	summary := &proto.StatusCheckSummaryEvent{
+		Resources: summaries,
+		Duration:  ptypes.DurationProto(time.Since(start)),
+	}
+	event.StatusCheckSummary(summary)
+	if runCtx.Opts.JSONOutput {
+		if err := printJSONSummary(out, summary); err != nil {
+			logrus.Warnf("unable to print the status check summary: %s", err)
+		}
+	}
+
+	return getSkaffoldDeployStatus(c, resources)
+}
+
+// resourceSummary describes the outcome of the status check of a resource.
+func resourceSummary(runCtx *runcontext.RunContext, r Resource, elapsed time.Duration) *proto.ResourceStatusCheckSummary {
+	summary := &proto.ResourceStatusCheckSummary{
+		Resource: eventResourceName(runCtx, r),
+		Status:   event.Succeeded,
+		Duration: ptypes.DurationProto(elapsed),
+	}
+
+	switch err := r.Status().Error(); {
+	case err == context.Canceled:
+		summary.Status = event.Cancelled
+	case err != nil:
+		summary.Status = event.Failed
+		summary.Err = err.Error()
+	}
+	return summary
+}
+
+// printJSONSummary prints the summary of a status check as a single line of JSON.
+func printJSONSummary(out io.Writer, summary *proto.StatusCheckSummaryEvent) error {
+	marshaler := jsonpb.Marshaler{}
+	if err := marshaler.Marshal(out, summary); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(out)
+	return err
+}
+
+// kubernetesClient returns a client for the kube-context of the run context.
+func kubernetesClient(runCtx *runcontext.RunContext) (kubernetes.Interface, error) {
+	if len(runCtx.Opts.KubeContexts) > 0 {
+		return pkgkubernetes.ClientForContext(runCtx.KubeContext)
+	}
+	return pkgkubernetes.Client()
+}
+
+// eventResourceName is the name of a resource in the status check events.
+// When deploying to multiple kube-contexts, it's prefixed with the kube-context.
+func eventResourceName(runCtx *runcontext.RunContext, r Resource) string {
+	if len(runCtx.Opts.KubeContexts) > 0 {
+		return fmt.Sprintf("%s/%s", runCtx.KubeContext, r)
+	}
+	return r.String()
 }
 
 func getDeployments(client kubernetes.Interface, ns string, l *DefaultLabeller, deadlineDuration time.Duration) ([]Resource, error) {
@@ -102,20 +249,245 @@ func getDeployments(client kubernetes.Interface, ns string, l *DefaultLabeller,
 
 	deployments := make([]Resource, 0, len(deps.Items))
 	for _, d := range deps.Items {
-		var deadline time.Duration
-		if d.Spec.ProgressDeadlineSeconds == nil || *d.Spec.ProgressDeadlineSeconds > int32(deadlineDuration.Seconds()) {
-			deadline = deadlineDuration
+		deployments = append(deployments, withStatusCheckAnnotation(d.ObjectMeta, resource.NewDeployment(d.Name, d.Namespace, deploymentDeadline(d, deadlineDuration))))
+	}
+
+	return deployments, nil
+}
+
+// deploymentDeadline is the shortest of the deployment's progress deadline and its status check deadline.
+func deploymentDeadline(d appsv1.Deployment, deadlineDuration time.Duration) time.Duration {
+	resourceDeadline := getResourceDeadline(d.ObjectMeta, deadlineDuration)
+	if d.Spec.ProgressDeadlineSeconds == nil || *d.Spec.ProgressDeadlineSeconds > int32(resourceDeadline.Seconds()) {
+		return resourceDeadline
+	}
+	return time.Duration(*d.Spec.ProgressDeadlineSeconds) * time.Second
+}
+
+func getStatefulSets(client kubernetes.Interface, ns string, l *DefaultLabeller, deadlineDuration time.Duration) ([]Resource, error) {
+	sets, err := client.AppsV1().StatefulSets(ns).List(metav1.ListOptions{
+		LabelSelector: l.RunIDKeyValueString(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch statefulsets")
+	}
+
+	statefulSets := make([]Resource, 0, len(sets.Items))
+	for _, s := range sets.Items {
+		statefulSets = append(statefulSets, withStatusCheckAnnotation(s.ObjectMeta, resource.NewStatefulSet(s.Name, s.Namespace, getResourceDeadline(s.ObjectMeta, deadlineDuration))))
+	}
+
+	return statefulSets, nil
+}
+
+func getDaemonSets(client kubernetes.Interface, ns string, l *DefaultLabeller, deadlineDuration time.Duration) ([]Resource, error) {
+	sets, err := client.AppsV1().DaemonSets(ns).List(metav1.ListOptions{
+		LabelSelector: l.RunIDKeyValueString(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch daemonsets")
+	}
+
+	daemonSets := make([]Resource, 0, len(sets.Items))
+	for _, d := range sets.Items {
+		daemonSets = append(daemonSets, withStatusCheckAnnotation(d.ObjectMeta, resource.NewDaemonSet(d.Name, d.Namespace, getResourceDeadline(d.ObjectMeta, deadlineDuration))))
+	}
+
+	return daemonSets, nil
+}
+
+// getAppliedWorkloads fetches the deployments, statefulsets and daemonsets among the resources
+// that a deployer applied. The deployments are returned separately from the other workloads.
+func getAppliedWorkloads(client kubernetes.Interface, runCtx *runcontext.RunContext, applied []AppliedResource, deadlineDuration time.Duration) ([]Resource, []Resource, error) {
+	var deployments, workloads []Resource
+	seen := map[AppliedResource]bool{}
+
+	for _, r := range applied {
+		if r.GVK.Group != "apps" && r.GVK.Group != "extensions" {
+			continue
+		}
+		if r.Namespace == "" {
+			r.Namespace = defaultNamespace(runCtx)
+		}
+		if seen[r] {
+			continue
+		}
+		seen[r] = true
+
+		switch r.GVK.Kind {
+		case "Deployment":
+			d, err := client.AppsV1().Deployments(r.Namespace).Get(r.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "could not fetch deployment %s", r.Name)
+			}
+			deployments = append(deployments, withStatusCheckAnnotation(d.ObjectMeta, resource.NewDeployment(d.Name, d.Namespace, deploymentDeadline(*d, deadlineDuration))))
+		case "StatefulSet":
+			s, err := client.AppsV1().StatefulSets(r.Namespace).Get(r.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "could not fetch statefulset %s", r.Name)
+			}
+			workloads = append(workloads, withStatusCheckAnnotation(s.ObjectMeta, resource.NewStatefulSet(s.Name, s.Namespace, getResourceDeadline(s.ObjectMeta, deadlineDuration))))
+		case "DaemonSet":
+			d, err := client.AppsV1().DaemonSets(r.Namespace).Get(r.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "could not fetch daemonset %s", r.Name)
+			}
+			workloads = append(workloads, withStatusCheckAnnotation(d.ObjectMeta, resource.NewDaemonSet(d.Name, d.Namespace, getResourceDeadline(d.ObjectMeta, deadlineDuration))))
+		}
+	}
+
+	return deployments, workloads, nil
+}
+
+// defaultNamespace is the namespace of the resources that don't specify one.
+func defaultNamespace(runCtx *runcontext.RunContext) string {
+	if runCtx.Opts.Namespace != "" {
+		return runCtx.Opts.Namespace
+	}
+
+	if config, err := kubectx.CurrentConfig(); err == nil {
+		if kubeContext, found := config.Contexts[runCtx.KubeContext]; found && kubeContext.Namespace != "" {
+			return kubeContext.Namespace
+		}
+	}
+	return metav1.NamespaceDefault
+}
+
+// getHPAs finds the horizontal pod autoscalers that target the given deployments.
+func getHPAs(client kubernetes.Interface, ns string, deployments []Resource, deadlineDuration time.Duration) ([]Resource, error) {
+	list, err := client.AutoscalingV2beta2().HorizontalPodAutoscalers(ns).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch horizontal pod autoscalers")
+	}
+
+	targets := map[string]bool{}
+	for _, d := range deployments {
+		targets[d.Namespace()+"/"+d.Name()] = true
+	}
+
+	var hpas []Resource
+	for _, hpa := range list.Items {
+		if hpa.Spec.ScaleTargetRef.Kind != "Deployment" || !targets[hpa.Namespace+"/"+hpa.Spec.ScaleTargetRef.Name] {
+			continue
+		}
+		hpas = append(hpas, withStatusCheckAnnotation(hpa.ObjectMeta, resource.NewHorizontalPodAutoscaler(hpa.Name, hpa.Namespace, getResourceDeadline(hpa.ObjectMeta, deadlineDuration))))
+	}
+
+	return hpas, nil
+}
+
+// skippedResource is a resource that opted out of the status check.
+type skippedResource struct {
+	Resource
+}
+
+// withStatusCheckAnnotation marks the resource as skipped if its
+// status check annotation is set to `false`.
+func withStatusCheckAnnotation(meta metav1.ObjectMeta, r Resource) Resource {
+	if value, found := meta.Annotations[StatusCheckAnnotation]; found {
+		if enabled, err := strconv.ParseBool(value); err != nil {
+			logrus.Warnf("ignoring invalid %s annotation on %s: %s", StatusCheckAnnotation, meta.Name, err)
+		} else if !enabled {
+			return &skippedResource{Resource: r}
+		}
+	}
+	return r
+}
+
+// withoutSkippedResources separates the resources to check from those that opted out.
+func withoutSkippedResources(resources []Resource) ([]Resource, []Resource) {
+	var checked, skipped []Resource
+	for _, r := range resources {
+		if s, ok := r.(*skippedResource); ok {
+			skipped = append(skipped, s.Resource)
 		} else {
-			deadline = time.Duration(*d.Spec.ProgressDeadlineSeconds) * time.Second
+			checked = append(checked, r)
 		}
-		deployments = append(deployments, resource.NewDeployment(d.Name, d.Namespace, deadline))
 	}
+	return checked, skipped
+}
 
-	return deployments, nil
+// getResourceDeadline returns the deadline set with the status check timeout annotation
+// or the given default deadline.
+func getResourceDeadline(meta metav1.ObjectMeta, deadline time.Duration) time.Duration {
+	timeout, found := meta.Annotations[StatusCheckTimeoutAnnotation]
+	if !found {
+		return deadline
+	}
+
+	override, err := parseStatusCheckTimeout(timeout)
+	if err != nil {
+		logrus.Warnf("ignoring invalid %s annotation on %s: %s", StatusCheckTimeoutAnnotation, meta.Name, err)
+		return deadline
+	}
+	return override
+}
+
+func parseStatusCheckTimeout(value string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0, fmt.Errorf("timeout should be positive, got %d", seconds)
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("timeout should be positive, got %s", value)
+	}
+	return timeout, nil
+}
+
+func maxDeadline(resources []Resource, deadline time.Duration) time.Duration {
+	for _, r := range resources {
+		if r.Deadline() > deadline {
+			deadline = r.Deadline()
+		}
+	}
+	return deadline
+}
+
+// statusCheckPollInterval converts the configured poll interval, in milliseconds,
+// into a duration within the supported bounds.
+func statusCheckPollInterval(milliseconds int) time.Duration {
+	if milliseconds <= 0 {
+		return time.Duration(defaultPollPeriodInMilliseconds) * time.Millisecond
+	}
+
+	interval := time.Duration(milliseconds) * time.Millisecond
+	switch {
+	case interval < minStatusCheckPollInterval:
+		logrus.Warnf("status check poll interval %v is too short, using %v", interval, minStatusCheckPollInterval)
+		return minStatusCheckPollInterval
+	case interval > maxStatusCheckPollInterval:
+		logrus.Warnf("status check poll interval %v is too long, using %v", interval, maxStatusCheckPollInterval)
+		return maxStatusCheckPollInterval
+	}
+	return interval
+}
+
+// nextPollInterval doubles the interval, up to the maximum, while the API server
+// rate-limits the requests. Otherwise, it goes back to the configured interval.
+func nextPollInterval(current, configured time.Duration, err error) time.Duration {
+	if err != resource.ErrRateLimited {
+		return configured
+	}
+
+	next := 2 * current
+	if next > maxStatusCheckPollInterval {
+		next = maxStatusCheckPollInterval
+	}
+	if next < configured {
+		next = configured
+	}
+	return next
 }
 
-func pollResourceStatus(ctx context.Context, runCtx *runcontext.RunContext, r Resource) {
-	pollDuration := time.Duration(defaultPollPeriodInMilliseconds) * time.Millisecond
+func pollResourceStatus(ctx context.Context, runCtx *runcontext.RunContext, r Resource, pollInterval time.Duration) {
+	pollDuration := pollInterval
 	// Add poll duration to account for one last attempt after progressDeadlineSeconds.
 	timeoutContext, cancel := context.WithTimeout(ctx, r.Deadline()+pollDuration)
 	logrus.Debugf("checking status %s", r)
@@ -130,14 +502,25 @@ func pollResourceStatus(ctx context.Context, runCtx *runcontext.RunContext, r Re
 			if r.IsStatusCheckComplete() {
 				return
 			}
+			pollDuration = nextPollInterval(pollDuration, pollInterval, r.Status().Error())
 		}
 	}
 }
 
-func getSkaffoldDeployStatus(c *counter) error {
+func getSkaffoldDeployStatus(c *counter, resources []Resource) error {
 	if c.failed == 0 {
 		return nil
 	}
+
+	var timeouts []string
+	for _, r := range resources {
+		if r.Status().Error() == context.DeadlineExceeded {
+			timeouts = append(timeouts, fmt.Sprintf("%s exceeded its %v timeout", r, r.Deadline()))
+		}
+	}
+	if len(timeouts) > 0 {
+		return fmt.Errorf("%d/%d deployment(s) failed: %s", c.failed, c.total, strings.Join(timeouts, ", "))
+	}
 	return fmt.Errorf("%d/%d deployment(s) failed", c.failed, c.total)
 }
 
@@ -163,7 +546,7 @@ func printStatusCheckSummary(out io.Writer, r Resource, pending int, total int)
 }
 
 // Print resource statuses until all status check are completed or context is cancelled.
-func printResourceStatus(ctx context.Context, out io.Writer, resources []Resource, deadline time.Duration) {
+func printResourceStatus(ctx context.Context, out io.Writer, runCtx *runcontext.RunContext, resources []Resource, deadline time.Duration) {
 	timeoutContext, cancel := context.WithTimeout(ctx, deadline)
 	defer cancel()
 	for {
@@ -172,7 +555,7 @@ func printResourceStatus(ctx context.Context, out io.Writer, resources []Resourc
 		case <-timeoutContext.Done():
 			return
 		case <-time.After(reportStatusTime):
-			allResourcesCheckComplete = printStatus(resources, out)
+			allResourcesCheckComplete = printStatus(runCtx, resources, out)
 		}
 		if allResourcesCheckComplete {
 			return
@@ -180,7 +563,7 @@ func printResourceStatus(ctx context.Context, out io.Writer, resources []Resourc
 	}
 }
 
-func printStatus(resources []Resource, out io.Writer) bool {
+func printStatus(runCtx *runcontext.RunContext, resources []Resource, out io.Writer) bool {
 	allResourcesCheckComplete := true
 	for _, r := range resources {
 		if r.IsStatusCheckComplete() {
@@ -188,6 +571,7 @@ func printStatus(resources []Resource, out io.Writer) bool {
 		}
 		allResourcesCheckComplete = false
 		if str := r.ReportSinceLastUpdated(); str != "" {
+			event.ResourceStatusCheckEventUpdated(eventResourceName(runCtx, r), r.Status().String())
 			color.Default.Fprintln(out, tabHeader, trimNewLine(str))
 		}
 	}
@@ -215,6 +599,12 @@ func newCounter(i int) *counter {
 func (c *counter) markProcessed(err error) int {
 	if err != nil {
 		atomic.AddInt32(&c.failed, 1)
+	} else {
+		atomic.AddInt32(&c.ready, 1)
 	}
 	return int(atomic.AddInt32(&c.pending, -1))
 }
+
+func (c *counter) readyCount() int {
+	return int(atomic.LoadInt32(&c.ready))
+}
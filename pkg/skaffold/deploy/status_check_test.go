@@ -23,12 +23,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/resource"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
 	utilpointer "k8s.io/utils/pointer"
 
@@ -120,6 +125,53 @@ func TestGetDeployments(t *testing.T) {
 				resource.NewDeployment("dep2", "test", time.Duration(200)*time.Second),
 			},
 		},
+		{
+			description: "status check timeout annotation overrides command flag deadline",
+			deps: []*appsv1.Deployment{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "dep1",
+						Namespace: "test",
+						Labels: map[string]string{
+							RunIDLabel: labeller.runID,
+						},
+						Annotations: map[string]string{
+							StatusCheckTimeoutAnnotation: "15m",
+						},
+					},
+					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(1200)},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "dep2",
+						Namespace: "test",
+						Labels: map[string]string{
+							RunIDLabel: labeller.runID,
+						},
+						Annotations: map[string]string{
+							StatusCheckTimeoutAnnotation: "30",
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "dep3",
+						Namespace: "test",
+						Labels: map[string]string{
+							RunIDLabel: labeller.runID,
+						},
+						Annotations: map[string]string{
+							StatusCheckTimeoutAnnotation: "invalid",
+						},
+					},
+				},
+			},
+			expected: []Resource{
+				resource.NewDeployment("dep1", "test", time.Duration(15)*time.Minute),
+				resource.NewDeployment("dep2", "test", time.Duration(30)*time.Second),
+				resource.NewDeployment("dep3", "test", time.Duration(200)*time.Second),
+			},
+		},
 		{
 			description: "no deployments",
 			expected:    []Resource{},
@@ -168,6 +220,27 @@ func TestGetDeployments(t *testing.T) {
 			},
 			expected: []Resource{},
 		},
+		{
+			description: "deployment excluded from the status check",
+			deps: []*appsv1.Deployment{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "dep1",
+						Namespace: "test",
+						Labels: map[string]string{
+							RunIDLabel: labeller.runID,
+						},
+						Annotations: map[string]string{
+							StatusCheckAnnotation: "false",
+						},
+					},
+					Spec: appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(100)},
+				},
+			},
+			expected: []Resource{
+				&skippedResource{Resource: resource.NewDeployment("dep1", "test", time.Duration(100)*time.Second)},
+			},
+		},
 		{
 			description: "deployment in correct namespace deployed by skaffold but different run",
 			deps: []*appsv1.Deployment{
@@ -200,6 +273,150 @@ func TestGetDeployments(t *testing.T) {
 	}
 }
 
+func TestWithoutSkippedResources(t *testing.T) {
+	web := resource.NewDeployment("web", "test", time.Minute)
+	job := resource.NewStatefulSet("job", "test", time.Minute)
+	invalid := resource.NewDaemonSet("invalid", "test", time.Minute)
+
+	resources := []Resource{
+		withStatusCheckAnnotation(metav1.ObjectMeta{Name: "web"}, web),
+		withStatusCheckAnnotation(metav1.ObjectMeta{Name: "job", Annotations: map[string]string{StatusCheckAnnotation: "false"}}, job),
+		withStatusCheckAnnotation(metav1.ObjectMeta{Name: "invalid", Annotations: map[string]string{StatusCheckAnnotation: "maybe"}}, invalid),
+	}
+
+	checked, skipped := withoutSkippedResources(resources)
+
+	testutil.CheckDeepEqual(t, []Resource{web, invalid}, checked, cmp.AllowUnexported(resource.Base{}, resource.Deployment{}, resource.DaemonSet{}, resource.Status{}))
+	testutil.CheckDeepEqual(t, []Resource{job}, skipped, cmp.AllowUnexported(resource.Base{}, resource.StatefulSet{}, resource.Status{}))
+}
+
+func TestGetStatefulSetsAndDaemonSets(t *testing.T) {
+	labeller := NewLabeller("")
+	runIDLabels := map[string]string{RunIDLabel: labeller.runID}
+	objs := []runtime.Object{
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "test", Labels: runIDLabels}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "test"}},
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{
+			Name:        "agent",
+			Namespace:   "test",
+			Labels:      runIDLabels,
+			Annotations: map[string]string{StatusCheckTimeoutAnnotation: "30"},
+		}},
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "test"}},
+	}
+	client := fakekubeclientset.NewSimpleClientset(objs...)
+
+	statefulSets, err := getStatefulSets(client, "test", labeller, time.Duration(200)*time.Second)
+	testutil.CheckErrorAndDeepEqual(t, false, err,
+		[]Resource{resource.NewStatefulSet("web", "test", time.Duration(200)*time.Second)}, statefulSets,
+		cmp.AllowUnexported(resource.Base{}, resource.StatefulSet{}, resource.Status{}))
+
+	daemonSets, err := getDaemonSets(client, "test", labeller, time.Duration(200)*time.Second)
+	testutil.CheckErrorAndDeepEqual(t, false, err,
+		[]Resource{resource.NewDaemonSet("agent", "test", time.Duration(30)*time.Second)}, daemonSets,
+		cmp.AllowUnexported(resource.Base{}, resource.DaemonSet{}, resource.Status{}))
+}
+
+func TestGetAppliedWorkloads(t *testing.T) {
+	objs := []runtime.Object{
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "test"},
+			Spec:       appsv1.DeploymentSpec{ProgressDeadlineSeconds: utilpointer.Int32Ptr(10)},
+		},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "not-applied", Namespace: "test"}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "other"}},
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "test"}},
+	}
+	applied := []AppliedResource{
+		{GVK: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, Name: "web"},
+		{GVK: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, Namespace: "test", Name: "web"},
+		{GVK: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "StatefulSet"}, Namespace: "other", Name: "db"},
+		{GVK: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DaemonSet"}, Name: "agent"},
+		{GVK: schema.GroupVersionKind{Version: "v1", Kind: "Service"}, Name: "web"},
+	}
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		client := fakekubeclientset.NewSimpleClientset(objs...)
+		runCtx := &runcontext.RunContext{Opts: config.SkaffoldOptions{Namespace: "test"}}
+
+		deployments, workloads, err := getAppliedWorkloads(client, runCtx, applied, 200*time.Second)
+
+		t.CheckError(false, err)
+		t.CheckDeepEqual([]Resource{resource.NewDeployment("web", "test", 10*time.Second)}, deployments,
+			cmp.AllowUnexported(resource.Base{}, resource.Deployment{}, resource.Status{}))
+		t.CheckDeepEqual([]Resource{
+			resource.NewStatefulSet("db", "other", 200*time.Second),
+			resource.NewDaemonSet("agent", "test", 200*time.Second),
+		}, workloads, cmp.AllowUnexported(resource.Base{}, resource.StatefulSet{}, resource.DaemonSet{}, resource.Status{}))
+	})
+}
+
+func TestGetAppliedWorkloadsNotFound(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		client := fakekubeclientset.NewSimpleClientset()
+		runCtx := &runcontext.RunContext{Opts: config.SkaffoldOptions{Namespace: "test"}}
+		applied := []AppliedResource{{GVK: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, Name: "web"}}
+
+		_, _, err := getAppliedWorkloads(client, runCtx, applied, 200*time.Second)
+
+		t.CheckError(true, err)
+	})
+}
+
+func TestGetHPAs(t *testing.T) {
+	deployments := []Resource{
+		resource.NewDeployment("dep1", "test", time.Duration(10)*time.Second),
+	}
+	tests := []struct {
+		description string
+		hpas        []*autoscalingv2beta2.HorizontalPodAutoscaler
+		expected    []Resource
+	}{
+		{
+			description: "hpa targeting a deployed deployment",
+			hpas: []*autoscalingv2beta2.HorizontalPodAutoscaler{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "hpa1", Namespace: "test"},
+					Spec: autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+						ScaleTargetRef: autoscalingv2beta2.CrossVersionObjectReference{Kind: "Deployment", Name: "dep1"},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "hpa2", Namespace: "test"},
+					Spec: autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+						ScaleTargetRef: autoscalingv2beta2.CrossVersionObjectReference{Kind: "Deployment", Name: "other"},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "hpa3", Namespace: "test"},
+					Spec: autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+						ScaleTargetRef: autoscalingv2beta2.CrossVersionObjectReference{Kind: "StatefulSet", Name: "dep1"},
+					},
+				},
+			},
+			expected: []Resource{
+				resource.NewHorizontalPodAutoscaler("hpa1", "test", time.Duration(200)*time.Second),
+			},
+		},
+		{
+			description: "no hpa",
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			objs := make([]runtime.Object, len(test.hpas))
+			for i, hpa := range test.hpas {
+				objs[i] = hpa
+			}
+			client := fakekubeclientset.NewSimpleClientset(objs...)
+			actual, err := getHPAs(client, "test", deployments, time.Duration(200)*time.Second)
+			t.CheckErrorAndDeepEqual(false, err, test.expected, actual,
+				cmp.AllowUnexported(resource.Base{}, resource.HorizontalPodAutoscaler{}, resource.Status{}))
+		})
+	}
+}
+
 type mockResource struct {
 	*resource.Base
 	inErr bool
@@ -219,6 +436,10 @@ func (m *mockResource) Deadline() time.Duration {
 func (m *mockResource) CheckStatus(context.Context, *runcontext.RunContext) {
 }
 
+func (m *mockResource) Status() resource.Status {
+	return resource.Status{}
+}
+
 func (m *mockResource) IsStatusCheckComplete() bool {
 	return m.done
 }
@@ -241,18 +462,89 @@ func TestPollResourceStatus(t *testing.T) {
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			t.Override(&defaultPollPeriodInMilliseconds, 0)
-			pollResourceStatus(context.Background(), nil, test.dummyResource)
+			pollResourceStatus(context.Background(), nil, test.dummyResource, 0)
 			t.CheckDeepEqual(test.dummyResource.inErr, test.isInErr)
 		})
 	}
 
 }
 
+func TestStatusCheckPollInterval(t *testing.T) {
+	tests := []struct {
+		description  string
+		milliseconds int
+		expected     time.Duration
+	}{
+		{
+			description: "default",
+			expected:    100 * time.Millisecond,
+		},
+		{
+			description:  "within bounds",
+			milliseconds: 2000,
+			expected:     2 * time.Second,
+		},
+		{
+			description:  "too short",
+			milliseconds: 10,
+			expected:     50 * time.Millisecond,
+		},
+		{
+			description:  "too long",
+			milliseconds: 60000,
+			expected:     10 * time.Second,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, statusCheckPollInterval(test.milliseconds))
+		})
+	}
+}
+
+func TestNextPollInterval(t *testing.T) {
+	tests := []struct {
+		description string
+		current     time.Duration
+		err         error
+		expected    time.Duration
+	}{
+		{
+			description: "not rate limited",
+			current:     time.Second,
+			expected:    100 * time.Millisecond,
+		},
+		{
+			description: "other error",
+			current:     time.Second,
+			err:         errors.New("deployment test not found"),
+			expected:    100 * time.Millisecond,
+		},
+		{
+			description: "rate limited",
+			current:     time.Second,
+			err:         resource.ErrRateLimited,
+			expected:    2 * time.Second,
+		},
+		{
+			description: "rate limited up to the maximum",
+			current:     8 * time.Second,
+			err:         resource.ErrRateLimited,
+			expected:    10 * time.Second,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, nextPollInterval(test.current, 100*time.Millisecond, test.err))
+		})
+	}
+}
+
 func TestGetDeployStatus(t *testing.T) {
 	tests := []struct {
 		description string
 		counter     *counter
+		resources   []Resource
 		expected    string
 		shouldErr   bool
 	}{
@@ -276,11 +568,22 @@ func TestGetDeployStatus(t *testing.T) {
 			description: "0 deployments",
 			counter:     &counter{},
 		},
+		{
+			description: "timeouts are reported",
+			counter:     &counter{total: 3, failed: 2},
+			resources: []Resource{
+				withStatus(resource.NewDeployment("dep1", "test", time.Duration(15)*time.Minute), "", context.DeadlineExceeded),
+				withStatus(resource.NewDeployment("dep2", "test", time.Duration(10)*time.Second), "", errors.New("error")),
+				withStatus(resource.NewDeployment("dep3", "test", time.Duration(10)*time.Second), "", nil),
+			},
+			expected:  "2/3 deployment(s) failed: test:deployment/dep1 exceeded its 15m0s timeout",
+			shouldErr: true,
+		},
 	}
 
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
-			err := getSkaffoldDeployStatus(test.counter)
+			err := getSkaffoldDeployStatus(test.counter, test.resources)
 			t.CheckError(test.shouldErr, err)
 			if test.shouldErr {
 				t.CheckErrorContains(test.expected, err)
@@ -402,7 +705,7 @@ func TestPrintStatus(t *testing.T) {
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			out := new(bytes.Buffer)
-			actual := printStatus(test.rs, out)
+			actual := printStatus(&runcontext.RunContext{}, test.rs, out)
 			t.CheckDeepEqual(test.expectedOut, out.String())
 			t.CheckDeepEqual(test.expected, actual)
 		})
@@ -413,3 +716,33 @@ func withStatus(d *resource.Deployment, details string, err error) *resource.Dep
 	d.UpdateStatus(details, err)
 	return d
 }
+
+func TestEventResourceName(t *testing.T) {
+	r := resource.NewDeployment("dep", "test", 0)
+
+	testutil.CheckDeepEqual(t, "test:deployment/dep", eventResourceName(&runcontext.RunContext{KubeContext: "cluster1"}, r))
+	testutil.CheckDeepEqual(t, "cluster1/test:deployment/dep", eventResourceName(&runcontext.RunContext{
+		Opts:        config.SkaffoldOptions{KubeContexts: []string{"cluster1", "cluster2"}},
+		KubeContext: "cluster1",
+	}, r))
+}
+
+func TestPrintJSONSummary(t *testing.T) {
+	runCtx := &runcontext.RunContext{KubeContext: "cluster1"}
+	summary := &proto.StatusCheckSummaryEvent{
+		Resources: []*proto.ResourceStatusCheckSummary{
+			resourceSummary(runCtx, withStatus(resource.NewDeployment("dep1", "test", 0), "", nil), time.Second),
+			resourceSummary(runCtx, withStatus(resource.NewDeployment("dep2", "test", 0), "", errors.New("could not stabilize")), 1500*time.Millisecond),
+			resourceSummary(runCtx, withStatus(resource.NewDeployment("dep3", "test", 0), "", context.Canceled), 2*time.Second),
+		},
+		Duration: ptypes.DurationProto(2 * time.Second),
+	}
+
+	out := new(bytes.Buffer)
+	err := printJSONSummary(out, summary)
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, `{"resources":[`+
+		`{"resource":"test:deployment/dep1","status":"Succeeded","duration":"1s"},`+
+		`{"resource":"test:deployment/dep2","status":"Failed","duration":"1.500s","err":"could not stabilize"},`+
+		`{"resource":"test:deployment/dep3","status":"Cancelled","duration":"2s"}],"duration":"2s"}`+"\n", out.String())
+}
@@ -245,7 +245,8 @@ func (l *localDaemon) Push(ctx context.Context, out io.Writer, ref string) (stri
 		digest = result.Digest
 	}
 
-	if err := streamDockerMessages(out, rc, auxCallback); err != nil {
+	stream := io.TeeReader(rc, newPushProgressWriter(ref))
+	if err := streamDockerMessages(out, stream, auxCallback); err != nil {
 		return "", err
 	}
 
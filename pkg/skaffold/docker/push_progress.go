@@ -0,0 +1,70 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/docker/docker/pkg/jsonmessage"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+)
+
+// For testing
+var imagePushProgress = event.ImagePushProgress
+
+// pushProgressWriter decodes the json messages streamed by the docker daemon
+// during a push and reports the progress of each layer.
+type pushProgressWriter struct {
+	ref string
+	buf bytes.Buffer
+}
+
+func newPushProgressWriter(ref string) *pushProgressWriter {
+	return &pushProgressWriter{ref: ref}
+}
+
+func (w *pushProgressWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+
+	for {
+		i := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+
+		line := w.buf.Next(i + 1)
+		w.report(line)
+	}
+
+	return len(p), nil
+}
+
+func (w *pushProgressWriter) report(line []byte) {
+	var msg jsonmessage.JSONMessage
+	if err := json.Unmarshal(line, &msg); err != nil {
+		// Not our job to report malformed messages.
+		return
+	}
+
+	if msg.ID == "" || msg.Progress == nil || msg.Progress.Total <= 0 {
+		return
+	}
+
+	imagePushProgress(w.ref, msg.ID, msg.Progress.Current, msg.Progress.Total)
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestPushProgressWriter(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var reported []string
+		t.Override(&imagePushProgress, func(image, layer string, pushed, total int64) {
+			reported = append(reported, fmt.Sprintf("%s %s %d/%d", image, layer, pushed, total))
+		})
+
+		w := newPushProgressWriter("gcr.io/project/img:tag")
+		stream := `{"status":"The push refers to repository [gcr.io/project/img]"}
+{"status":"Preparing","progressDetail":{},"id":"abc"}
+{"status":"Pushing","progressDetail":{"current":512,"total":2048},"progress":"[=>   ]","id":"abc"}
+{"status":"Pushing","progressDetail":{"current":2048,"total":2048},"progress":"[=====]","id":"abc"}
+{"status":"Pushed","progressDetail":{},"id":"abc"}
+{"status":"Pushing","progressDetail":{"current":10,"total":100},"id":"def"}
+`
+		// Messages can be split across writes.
+		w.Write([]byte(stream[:100]))
+		w.Write([]byte(stream[100:]))
+
+		t.CheckDeepEqual([]string{
+			"gcr.io/project/img:tag abc 512/2048",
+			"gcr.io/project/img:tag abc 2048/2048",
+			"gcr.io/project/img:tag def 10/100",
+		}, reported)
+	})
+}
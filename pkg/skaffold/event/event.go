@@ -19,12 +19,15 @@ package event
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
 	"github.com/GoogleContainerTools/skaffold/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
 )
 
 const (
@@ -35,24 +38,67 @@ const (
 	Info       = "Information"
 	Started    = "Started"
 	Succeeded  = "Succeeded"
+	Skipped    = "Skipped"
+	Cancelled  = "Cancelled"
+	Validated  = "Validated"
+	Retrying   = "Retrying"
+	// ShuttingDown is the status of a deploy that's allowed to complete after an interruption.
+	ShuttingDown = "Shutting Down"
 )
 
-var handler = &eventHandler{}
+// Categories of warnings
+const (
+	DeprecatedField = "DeprecatedField"
+	ImageMismatch   = "ImageMismatch"
+)
+
+// defaultProtocol is the protocol of forwarded ports that don't specify one.
+const defaultProtocol = "TCP"
+
+var (
+	handler = &eventHandler{}
+
+	// defaultLogSize is the number of entries kept in the event log
+	// when no size is set on the eventHandler.
+	defaultLogSize = 10000
+
+	// listenerBufferSize is the number of entries that can be waiting
+	// to be sent to a listener before new entries are dropped.
+	listenerBufferSize = 1000
+
+	// pushProgressInterval is the minimum time between two progress
+	// events for the same layer of an image being pushed.
+	pushProgressInterval = time.Second
+)
 
 type eventHandler struct {
-	eventLog []proto.LogEntry
+	eventLog logRing
+	logSize  int
 	logLock  sync.Mutex
 
 	state     proto.State
 	stateLock sync.Mutex
 
+	buildStarts     map[string]time.Time
+	buildStartsLock sync.Mutex
+
+	// pushProgress is the time of the last progress event sent for each layer being pushed.
+	pushProgress     map[string]time.Time
+	pushProgressLock sync.Mutex
+
 	listeners []*listener
+
+	// revision is incremented on each change of the state.
+	// Both are guarded by stateLock.
+	revision       int64
+	stateListeners []*stateListener
 }
 
+// listener receives the entries logged after it subscribed.
 type listener struct {
-	callback func(*proto.LogEntry) error
-	errors   chan error
-	closed   bool
+	entries chan *proto.LogEntry
+	// dropped is the number of entries that couldn't be sent since the last sent one.
+	dropped int64
 }
 
 func GetState() (*proto.State, error) {
@@ -87,42 +133,81 @@ func (ev *eventHandler) logEvent(entry proto.LogEntry) {
 	ev.logLock.Lock()
 
 	for _, listener := range ev.listeners {
-		if listener.closed {
-			continue
+		sent := &entry
+		if listener.dropped > 0 {
+			withDropped := entry
+			withDropped.DroppedEntries = listener.dropped
+			sent = &withDropped
 		}
 
-		if err := listener.callback(&entry); err != nil {
-			listener.errors <- err
-			listener.closed = true
+		// Never block on a slow listener
+		select {
+		case listener.entries <- sent:
+			listener.dropped = 0
+		default:
+			listener.dropped++
 		}
 	}
-	ev.eventLog = append(ev.eventLog, entry)
+	ev.eventLog.add(entry, ev.maxLogSize())
 
 	ev.logLock.Unlock()
 }
 
-func (ev *eventHandler) forEachEvent(callback func(*proto.LogEntry) error) error {
+func (ev *eventHandler) maxLogSize() int {
+	if ev.logSize > 0 {
+		return ev.logSize
+	}
+	return defaultLogSize
+}
+
+// subscribe registers a new listener and returns the entries logged so far.
+func (ev *eventHandler) subscribe() (*listener, []proto.LogEntry) {
 	listener := &listener{
-		callback: callback,
-		errors:   make(chan error),
+		entries: make(chan *proto.LogEntry, listenerBufferSize),
 	}
 
 	ev.logLock.Lock()
-
-	oldEvents := make([]proto.LogEntry, len(ev.eventLog))
-	copy(oldEvents, ev.eventLog)
+	oldEvents := ev.eventLog.list()
 	ev.listeners = append(ev.listeners, listener)
-
 	ev.logLock.Unlock()
 
+	return listener, oldEvents
+}
+
+// unsubscribe stops sending entries to a listener.
+func (ev *eventHandler) unsubscribe(listener *listener) {
+	ev.logLock.Lock()
+	defer ev.logLock.Unlock()
+
+	for i, l := range ev.listeners {
+		if l == listener {
+			ev.listeners = append(ev.listeners[:i], ev.listeners[i+1:]...)
+			close(listener.entries)
+			return
+		}
+	}
+}
+
+// forEachEvent replays the entries kept in the event log and then
+// sends the new entries, until the callback returns an error.
+// Each call has its own subscription so that multiple consumers
+// can read the same entries.
+func (ev *eventHandler) forEachEvent(callback func(*proto.LogEntry) error) error {
+	listener, oldEvents := ev.subscribe()
+	defer ev.unsubscribe(listener)
+
 	for i := range oldEvents {
 		if err := callback(&oldEvents[i]); err != nil {
-			// listener should maybe be closed
 			return err
 		}
 	}
 
-	return <-listener.errors
+	for entry := range listener.entries {
+		if err := callback(entry); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func emptyState(build latest.BuildConfig) proto.State {
@@ -134,9 +219,17 @@ func emptyState(build latest.BuildConfig) proto.State {
 }
 
 func emptyStateWithArtifacts(builds map[string]string) proto.State {
+	tests := map[string]string{}
+	for k := range builds {
+		tests[k] = NotStarted
+	}
 	return proto.State{
 		BuildState: &proto.BuildState{
 			Artifacts: builds,
+			Durations: map[string]*duration.Duration{},
+		},
+		TestState: &proto.TestState{
+			Artifacts: tests,
 		},
 		DeployState: &proto.DeployState{
 			Status: NotStarted,
@@ -182,16 +275,28 @@ func StatusCheckEventFailed(err error) {
 	})
 }
 
+// StatusCheckEventCancelled notifies that the status check was interrupted
+// before all the resources were checked.
+func StatusCheckEventCancelled() {
+	handler.handleStatusCheckEvent(&proto.StatusCheckEvent{
+		Status: Cancelled,
+	})
+}
+
 func StatusCheckEventStarted() {
 	handler.handleStatusCheckEvent(&proto.StatusCheckEvent{
 		Status: Started,
 	})
 }
 
-func StatusCheckEventInProgress(s string) {
+// StatusCheckEventInProgress notifies of the number of resources that have
+// stabilized so far, out of all the resources being checked.
+func StatusCheckEventInProgress(ready, total int) {
 	handler.handleStatusCheckEvent(&proto.StatusCheckEvent{
-		Status:  InProgress,
-		Message: s,
+		Status:         InProgress,
+		Message:        fmt.Sprintf("[%d/%d deployment(s) still pending]", total-ready, total),
+		TotalResources: int32(total),
+		ReadyResources: int32(ready),
 	})
 }
 
@@ -219,13 +324,122 @@ func ResourceStatusCheckEventUpdated(r string, status string) {
 	})
 }
 
+// StatusCheckSummary notifies the outcome of a completed status check.
+func StatusCheckSummary(summary *proto.StatusCheckSummaryEvent) {
+	go handler.handle(&proto.Event{
+		EventType: &proto.Event_StatusCheckSummaryEvent{
+			StatusCheckSummaryEvent: summary,
+		},
+	})
+}
+
+// DeployRollbackInProgress notifies that the rollback of a failed deployment has been started.
+func DeployRollbackInProgress() {
+	handler.handleDeployRollbackEvent(&proto.DeployRollbackEvent{Status: InProgress})
+}
+
+// DeployRollbackFailed notifies that the rollback of a failed deployment has failed.
+// The resources that were rolled back before the failure are listed.
+func DeployRollbackFailed(resources []string, err error) {
+	handler.handleDeployRollbackEvent(&proto.DeployRollbackEvent{Status: Failed, Resources: resources, Err: err.Error()})
+}
+
+// DeployRollbackComplete notifies that the given resources were rolled back.
+func DeployRollbackComplete(resources []string) {
+	handler.handleDeployRollbackEvent(&proto.DeployRollbackEvent{Status: Complete, Resources: resources})
+}
+
+// DeployHookInProgress notifies that a pre-deploy or post-deploy command has been started.
+func DeployHookInProgress(phase, command string) {
+	handler.handleDeployHookEvent(&proto.DeployHookEvent{Phase: phase, Command: command, Status: InProgress})
+}
+
+// DeployHookFailed notifies that a pre-deploy or post-deploy command has failed.
+func DeployHookFailed(phase, command string, err error) {
+	handler.handleDeployHookEvent(&proto.DeployHookEvent{Phase: phase, Command: command, Status: Failed, Err: err.Error()})
+}
+
+// DeployHookComplete notifies that a pre-deploy or post-deploy command has completed.
+func DeployHookComplete(phase, command string) {
+	handler.handleDeployHookEvent(&proto.DeployHookEvent{Phase: phase, Command: command, Status: Complete})
+}
+
+// Warn notifies a warning of the given category.
+// The code identifies the exact warning, so that tools can act on it.
+func Warn(category, code, message string) {
+	go handler.handle(&proto.Event{
+		EventType: &proto.Event_WarningEvent{
+			WarningEvent: &proto.WarningEvent{
+				Category: category,
+				Code:     code,
+				Message:  message,
+			},
+		},
+	})
+}
+
+// DeployToKubeContextInProgress notifies that a deploy to one of several kube-contexts has started.
+func DeployToKubeContextInProgress(kubeContext string) {
+	handler.handleDeployEvent(&proto.DeployEvent{Status: InProgress, KubeContext: kubeContext})
+}
+
+// DeployToKubeContextFailed notifies that a deploy to one of several kube-contexts has failed.
+func DeployToKubeContextFailed(kubeContext string, err error) {
+	handler.handleDeployEvent(&proto.DeployEvent{Status: Failed, Err: err.Error(), KubeContext: kubeContext})
+}
+
+// DeployToKubeContextComplete notifies that a deploy to one of several kube-contexts has completed.
+func DeployToKubeContextComplete(kubeContext string) {
+	handler.handleDeployEvent(&proto.DeployEvent{Status: Complete, KubeContext: kubeContext})
+}
+
+// DeployValidated notifies that the manifests were validated by the cluster
+// without being deployed.
+func DeployValidated() {
+	handler.handleDeployEvent(&proto.DeployEvent{Status: Validated})
+}
+
+// DeploySkipped notifies that a deployment was skipped because
+// the manifests didn't change since the previous deployment.
+func DeploySkipped() {
+	handler.handleDeployEvent(&proto.DeployEvent{Status: Skipped})
+}
+
+// DeployRetry notifies that the given attempt at deploying failed
+// with a transient error and that the deploy is going to be retried.
+func DeployRetry(attempt int, err error) {
+	handler.handleDeployEvent(&proto.DeployEvent{Status: Retrying, Attempt: int32(attempt), Err: err.Error()})
+}
+
+// DeployShuttingDown notifies that the deploy in progress was interrupted
+// and that it's given some time to complete before being aborted.
+func DeployShuttingDown() {
+	handler.handleDeployEvent(&proto.DeployEvent{Status: ShuttingDown})
+}
+
 // DeployComplete notifies that a deployment has completed.
+// The event carries the time spent so far in each phase of the deploy.
 func DeployComplete() {
 	handler.handleDeployEvent(&proto.DeployEvent{Status: Complete})
 }
 
+// Phases of a deploy whose durations are recorded in the deploy state.
+const (
+	RenderPhase      = "render"
+	ApplyPhase       = "apply"
+	StatusCheckPhase = "status-check"
+)
+
+// DeployPhaseCompleted records the time spent in a phase of the deploy.
+// Durations are accumulated, across deployers, until the state is reset
+// for the next deploy.
+func DeployPhaseCompleted(phase string, d time.Duration) {
+	handler.addDeployTiming(phase, d)
+}
+
 // BuildInProgress notifies that a build has been started.
 func BuildInProgress(imageName string) {
+	handler.startBuildTimer(imageName)
 	handler.handleBuildEvent(&proto.BuildEvent{Artifact: imageName, Status: InProgress})
 }
 
@@ -235,12 +449,164 @@ func BuildFailed(imageName string, err error) {
 }
 
 // BuildComplete notifies that a build has completed.
-func BuildComplete(imageName string) {
-	handler.handleBuildEvent(&proto.BuildEvent{Artifact: imageName, Status: Complete})
+// The event carries the resulting image reference and the time elapsed
+// since the build was started.
+func BuildComplete(imageName, image string) {
+	handler.handleBuildEvent(&proto.BuildEvent{Artifact: imageName, Status: Complete, Image: image, Duration: handler.buildDuration(imageName)})
+}
+
+// BuildCacheHit notifies that an artifact wasn't built because
+// the given image was found in the cache.
+func BuildCacheHit(imageName, image string) {
+	go handler.handle(&proto.Event{
+		EventType: &proto.Event_BuildCacheHitEvent{
+			BuildCacheHitEvent: &proto.BuildCacheHitEvent{
+				Artifact: imageName,
+				Image:    image,
+			},
+		},
+	})
+}
+
+// ImagePushProgress notifies of the number of bytes pushed so far for a layer of an image.
+// Rapid updates for the same layer are coalesced to avoid flooding the event log.
+func ImagePushProgress(image, layer string, pushed, total int64) {
+	if !handler.shouldReportPushProgress(image, layer, pushed, total) {
+		return
+	}
+
+	go handler.handle(&proto.Event{
+		EventType: &proto.Event_ImagePushProgressEvent{
+			ImagePushProgressEvent: &proto.ImagePushProgressEvent{
+				Image:       image,
+				Layer:       layer,
+				BytesPushed: pushed,
+				BytesTotal:  total,
+			},
+		},
+	})
+}
+
+// shouldReportPushProgress returns true when no progress was reported for the
+// same layer in the last pushProgressInterval, or when the layer is fully pushed.
+func (ev *eventHandler) shouldReportPushProgress(image, layer string, pushed, total int64) bool {
+	key := image + "@" + layer
+	now := time.Now()
+
+	ev.pushProgressLock.Lock()
+	defer ev.pushProgressLock.Unlock()
+
+	if total > 0 && pushed >= total {
+		delete(ev.pushProgress, key)
+		return true
+	}
+
+	if last, found := ev.pushProgress[key]; found && now.Sub(last) < pushProgressInterval {
+		return false
+	}
+
+	if ev.pushProgress == nil {
+		ev.pushProgress = map[string]time.Time{}
+	}
+	ev.pushProgress[key] = now
+	return true
+}
+
+// ApplicationLog notifies that a container of a deployed pod has logged a line.
+func ApplicationLog(podName, containerName, message string) {
+	go handler.handle(&proto.Event{
+		EventType: &proto.Event_ApplicationLogEvent{
+			ApplicationLogEvent: &proto.ApplicationLogEvent{
+				PodName:       podName,
+				ContainerName: containerName,
+				Message:       message,
+			},
+		},
+	})
+}
+
+// FileChanged notifies that the dev loop detected changes to the given files,
+// that belong to the given artifacts.
+func FileChanged(paths, artifacts []string) {
+	go handler.handle(&proto.Event{
+		EventType: &proto.Event_FileChangedEvent{
+			FileChangedEvent: &proto.FileChangedEvent{
+				Paths:     paths,
+				Artifacts: artifacts,
+			},
+		},
+	})
+}
+
+// RunComplete notifies that `skaffold run` is complete, with the tags of the
+// images that were deployed and the resources that were created or updated.
+// A nil error means that the run succeeded.
+func RunComplete(images map[string]string, resources []*proto.DeployedResource, err error) {
+	rce := &proto.RunCompleteEvent{
+		Status:    Succeeded,
+		Images:    images,
+		Resources: resources,
+	}
+	if err != nil {
+		rce.Status = Failed
+		rce.Err = err.Error()
+	}
+
+	go handler.handle(&proto.Event{
+		EventType: &proto.Event_RunCompleteEvent{
+			RunCompleteEvent: rce,
+		},
+	})
+}
+
+// TestInProgress notifies that the tests for an artifact have been started.
+func TestInProgress(imageName string) {
+	handler.handleTestEvent(&proto.TestEvent{Artifact: imageName, Status: InProgress})
+}
+
+// TestFailed notifies that the tests for an artifact have failed.
+func TestFailed(imageName string, err error) {
+	handler.handleTestEvent(&proto.TestEvent{Artifact: imageName, Status: Failed, Err: err.Error()})
+}
+
+// TestComplete notifies that the tests for an artifact have completed.
+func TestComplete(imageName string) {
+	handler.handleTestEvent(&proto.TestEvent{Artifact: imageName, Status: Complete})
+}
+
+// TestCommandInProgress notifies that a test command has been started for an artifact.
+func TestCommandInProgress(imageName, command string) {
+	handler.handleTestEvent(&proto.TestEvent{Artifact: imageName, Command: command, Status: InProgress})
 }
 
+// TestCommandFailed notifies that a test command has failed for an artifact.
+func TestCommandFailed(imageName, command string, err error) {
+	handler.handleTestEvent(&proto.TestEvent{Artifact: imageName, Command: command, Status: Failed, Err: err.Error()})
+}
+
+// TestCommandComplete notifies that a test command has completed for an artifact.
+func TestCommandComplete(imageName, command string) {
+	handler.handleTestEvent(&proto.TestEvent{Artifact: imageName, Command: command, Status: Complete})
+}
+
+// TestCommandOutput notifies that a test command printed the given line.
+// Lines are handled synchronously so that they are logged in order.
+func TestCommandOutput(imageName, command, line string) {
+	handler.handle(&proto.Event{
+		EventType: &proto.Event_TestEvent{
+			TestEvent: &proto.TestEvent{Artifact: imageName, Command: command, Status: Info, Output: line},
+		},
+	})
+}
+
+// PortForwarded notifies that a remote port has been forwarded locally.
 // PortForwarded notifies that a remote port has been forwarded locally.
-func PortForwarded(localPort, remotePort int32, podName, containerName, namespace string, portName string, resourceType, resourceName string) {
+// The protocol defaults to TCP.
+func PortForwarded(localPort, remotePort int32, podName, containerName, namespace string, portName string, resourceType, resourceName string, protocol string) {
+	if protocol == "" {
+		protocol = defaultProtocol
+	}
+
 	go handler.handle(&proto.Event{
 		EventType: &proto.Event_PortEvent{
 			PortEvent: &proto.PortEvent{
@@ -252,6 +618,7 @@ func PortForwarded(localPort, remotePort int32, podName, containerName, namespac
 				PortName:      portName,
 				ResourceType:  resourceType,
 				ResourceName:  resourceName,
+				Protocol:      protocol,
 			},
 		},
 	})
@@ -260,6 +627,7 @@ func PortForwarded(localPort, remotePort int32, podName, containerName, namespac
 func (ev *eventHandler) setState(state proto.State) {
 	ev.stateLock.Lock()
 	ev.state = state
+	ev.stateChanged(allStateFields...)
 	ev.stateLock.Unlock()
 }
 
@@ -271,6 +639,38 @@ func (ev *eventHandler) handleDeployEvent(e *proto.DeployEvent) {
 	})
 }
 
+func (ev *eventHandler) handleDeployRollbackEvent(e *proto.DeployRollbackEvent) {
+	go ev.handle(&proto.Event{
+		EventType: &proto.Event_DeployRollbackEvent{
+			DeployRollbackEvent: e,
+		},
+	})
+}
+
+func (ev *eventHandler) handleDeployHookEvent(e *proto.DeployHookEvent) {
+	go ev.handle(&proto.Event{
+		EventType: &proto.Event_DeployHookEvent{
+			DeployHookEvent: e,
+		},
+	})
+}
+
+// PortForwardTerminated notifies that a port is not forwarded anymore because its pod went away.
+func PortForwardTerminated(localPort, remotePort int32, podName, containerName, namespace string, portName string) {
+	go handler.handle(&proto.Event{
+		EventType: &proto.Event_PortForwardTerminatedEvent{
+			PortForwardTerminatedEvent: &proto.PortForwardTerminatedEvent{
+				LocalPort:     localPort,
+				RemotePort:    remotePort,
+				PodName:       podName,
+				ContainerName: containerName,
+				Namespace:     namespace,
+				PortName:      portName,
+			},
+		},
+	})
+}
+
 func (ev *eventHandler) handleStatusCheckEvent(e *proto.StatusCheckEvent) {
 	go ev.handle(&proto.Event{
 		EventType: &proto.Event_StatusCheckEvent{
@@ -279,6 +679,32 @@ func (ev *eventHandler) handleStatusCheckEvent(e *proto.StatusCheckEvent) {
 	})
 }
 
+// updateStatusCheckProgress records the progress of the status check.
+// Progress events are sent concurrently, so they can arrive out of order:
+// the number of ready resources never decreases and a completed status check
+// isn't marked as in progress again.
+// Must be called with stateLock held.
+func (ev *eventHandler) updateStatusCheckProgress(se *proto.StatusCheckEvent) {
+	state := ev.state.StatusCheckState
+	switch state.Status {
+	case Succeeded, Failed, Cancelled:
+	default:
+		state.Status = InProgress
+	}
+
+	if se.TotalResources != state.TotalResources {
+		state.TotalResources = se.TotalResources
+		state.ReadyResources = 0
+	}
+	if se.ReadyResources > state.ReadyResources {
+		state.ReadyResources = se.ReadyResources
+	}
+	state.Percentage = 0
+	if state.TotalResources > 0 {
+		state.Percentage = state.ReadyResources * 100 / state.TotalResources
+	}
+}
+
 func (ev *eventHandler) handleResourceStatusCheckEvent(e *proto.ResourceStatusCheckEvent) {
 	go ev.handle(&proto.Event{
 		EventType: &proto.Event_ResourceStatusCheckEvent{
@@ -295,6 +721,87 @@ func (ev *eventHandler) handleBuildEvent(e *proto.BuildEvent) {
 	})
 }
 
+func (ev *eventHandler) startBuildTimer(imageName string) {
+	ev.buildStartsLock.Lock()
+	defer ev.buildStartsLock.Unlock()
+
+	if ev.buildStarts == nil {
+		ev.buildStarts = map[string]time.Time{}
+	}
+	ev.buildStarts[imageName] = time.Now()
+}
+
+// buildDuration returns the time elapsed since the build of the given image was started
+// or nil if the start of the build is unknown.
+func (ev *eventHandler) buildDuration(imageName string) *duration.Duration {
+	ev.buildStartsLock.Lock()
+	defer ev.buildStartsLock.Unlock()
+
+	start, found := ev.buildStarts[imageName]
+	if !found {
+		return nil
+	}
+	delete(ev.buildStarts, imageName)
+
+	return ptypes.DurationProto(time.Since(start))
+}
+
+func (ev *eventHandler) addDeployTiming(phase string, d time.Duration) {
+	ev.stateLock.Lock()
+	defer ev.stateLock.Unlock()
+
+	timings := ev.state.DeployState.Timings
+	if timings == nil {
+		timings = &proto.DeployTimings{}
+		ev.state.DeployState.Timings = timings
+	}
+
+	switch phase {
+	case RenderPhase:
+		timings.Render = addDuration(timings.Render, d)
+	case ApplyPhase:
+		timings.Apply = addDuration(timings.Apply, d)
+	case StatusCheckPhase:
+		timings.StatusCheck = addDuration(timings.StatusCheck, d)
+	default:
+		return
+	}
+	ev.stateChanged(deployStateField)
+}
+
+// addDuration adds d to a proto duration that may be nil.
+func addDuration(total *duration.Duration, d time.Duration) *duration.Duration {
+	if total != nil {
+		if sum, err := ptypes.Duration(total); err == nil {
+			d += sum
+		}
+	}
+	return ptypes.DurationProto(d)
+}
+
+func copyDeployTimings(timings *proto.DeployTimings) *proto.DeployTimings {
+	copyDuration := func(d *duration.Duration) *duration.Duration {
+		if d == nil {
+			return nil
+		}
+		return &duration.Duration{Seconds: d.Seconds, Nanos: d.Nanos}
+	}
+
+	return &proto.DeployTimings{
+		Render:      copyDuration(timings.Render),
+		Apply:       copyDuration(timings.Apply),
+		StatusCheck: copyDuration(timings.StatusCheck),
+	}
+}
+
+func (ev *eventHandler) handleTestEvent(e *proto.TestEvent) {
+	go ev.handle(&proto.Event{
+		EventType: &proto.Event_TestEvent{
+			TestEvent: e,
+		},
+	})
+}
+
 func LogSkaffoldMetadata(info *version.Info) {
 	handler.logEvent(proto.LogEntry{
 		Timestamp: ptypes.TimestampNow(),
@@ -319,6 +826,20 @@ func (ev *eventHandler) handle(event *proto.Event) {
 		be := e.BuildEvent
 		ev.stateLock.Lock()
 		ev.state.BuildState.Artifacts[be.Artifact] = be.Status
+		delete(ev.state.BuildState.Cached, be.Artifact)
+		if be.Duration != nil {
+			if ev.state.BuildState.Durations == nil {
+				ev.state.BuildState.Durations = map[string]*duration.Duration{}
+			}
+			ev.state.BuildState.Durations[be.Artifact] = be.Duration
+		}
+		if be.Image != "" {
+			if ev.state.BuildState.Images == nil {
+				ev.state.BuildState.Images = map[string]string{}
+			}
+			ev.state.BuildState.Images[be.Artifact] = be.Image
+		}
+		ev.stateChanged(buildStateField)
 		ev.stateLock.Unlock()
 		switch be.Status {
 		case InProgress:
@@ -330,10 +851,76 @@ func (ev *eventHandler) handle(event *proto.Event) {
 			// logEntry.Err = be.Err
 		default:
 		}
+	case *proto.Event_BuildCacheHitEvent:
+		ce := e.BuildCacheHitEvent
+		ev.stateLock.Lock()
+		ev.state.BuildState.Artifacts[ce.Artifact] = Complete
+		if ev.state.BuildState.Images == nil {
+			ev.state.BuildState.Images = map[string]string{}
+		}
+		ev.state.BuildState.Images[ce.Artifact] = ce.Image
+		if ev.state.BuildState.Cached == nil {
+			ev.state.BuildState.Cached = map[string]bool{}
+		}
+		ev.state.BuildState.Cached[ce.Artifact] = true
+		ev.stateChanged(buildStateField)
+		ev.stateLock.Unlock()
+		logEntry.Entry = fmt.Sprintf("Found artifact %s in the cache", ce.Artifact)
+	case *proto.Event_ImagePushProgressEvent:
+		pe := e.ImagePushProgressEvent
+		logEntry.Entry = fmt.Sprintf("Pushing layer %s of %s: %d/%d bytes", pe.Layer, pe.Image, pe.BytesPushed, pe.BytesTotal)
+	case *proto.Event_ApplicationLogEvent:
+		le := e.ApplicationLogEvent
+		logEntry.Entry = fmt.Sprintf("[%s %s] %s", le.PodName, le.ContainerName, strings.TrimSuffix(le.Message, "\n"))
+	case *proto.Event_FileChangedEvent:
+		fe := e.FileChangedEvent
+		if len(fe.Artifacts) > 0 {
+			logEntry.Entry = fmt.Sprintf("%d file(s) changed for artifact(s) %s", len(fe.Paths), strings.Join(fe.Artifacts, ", "))
+		} else {
+			logEntry.Entry = fmt.Sprintf("%d file(s) changed", len(fe.Paths))
+		}
+	case *proto.Event_RunCompleteEvent:
+		rce := e.RunCompleteEvent
+		ev.stateLock.Lock()
+		ev.state.RunStatus = rce.Status
+		ev.stateChanged(runStatusField)
+		ev.stateLock.Unlock()
+		if rce.Status == Succeeded {
+			logEntry.Entry = "Run succeeded"
+		} else {
+			logEntry.Entry = "Run failed"
+		}
+	case *proto.Event_TestEvent:
+		te := e.TestEvent
+		if te.Command != "" {
+			handleTestCommandEvent(te, logEntry)
+			break
+		}
+		ev.stateLock.Lock()
+		ev.state.TestState.Artifacts[te.Artifact] = te.Status
+		ev.stateChanged(testStateField)
+		ev.stateLock.Unlock()
+		switch te.Status {
+		case InProgress:
+			logEntry.Entry = fmt.Sprintf("Test started for artifact %s", te.Artifact)
+		case Complete:
+			logEntry.Entry = fmt.Sprintf("Test completed for artifact %s", te.Artifact)
+		case Failed:
+			logEntry.Entry = fmt.Sprintf("Test failed for artifact %s", te.Artifact)
+		default:
+		}
 	case *proto.Event_DeployEvent:
 		de := e.DeployEvent
+		if de.KubeContext != "" {
+			ev.handleKubeContextDeployEvent(de, logEntry)
+			break
+		}
 		ev.stateLock.Lock()
 		ev.state.DeployState.Status = de.Status
+		if de.Status == Complete && ev.state.DeployState.Timings != nil {
+			de.Timings = copyDeployTimings(ev.state.DeployState.Timings)
+		}
+		ev.stateChanged(deployStateField)
 		ev.stateLock.Unlock()
 		switch de.Status {
 		case InProgress:
@@ -343,35 +930,115 @@ func (ev *eventHandler) handle(event *proto.Event) {
 		case Failed:
 			logEntry.Entry = "Deploy failed"
 			// logEntry.Err = de.Err
+		case Skipped:
+			logEntry.Entry = "Deploy skipped, manifests are unchanged"
+		case Validated:
+			logEntry.Entry = "Deploy validated, nothing was deployed"
+		case Retrying:
+			logEntry.Entry = fmt.Sprintf("Deploy attempt %d failed with a transient error, retrying", de.Attempt)
+		case ShuttingDown:
+			logEntry.Entry = "Deploy interrupted, waiting for it to complete"
+		default:
+		}
+	case *proto.Event_DeployRollbackEvent:
+		re := e.DeployRollbackEvent
+		ev.stateLock.Lock()
+		ev.state.DeployState.RollbackStatus = re.Status
+		ev.stateChanged(deployStateField)
+		ev.stateLock.Unlock()
+		switch re.Status {
+		case InProgress:
+			logEntry.Entry = "Rollback started"
+		case Complete:
+			logEntry.Entry = fmt.Sprintf("Rolled back %s", strings.Join(re.Resources, ", "))
+		case Failed:
+			logEntry.Entry = fmt.Sprintf("Rollback failed after rolling back [%s]", strings.Join(re.Resources, ", "))
 		default:
 		}
+	case *proto.Event_DeployHookEvent:
+		he := e.DeployHookEvent
+		switch he.Status {
+		case InProgress:
+			logEntry.Entry = fmt.Sprintf("Running %s hook: %s", he.Phase, he.Command)
+		case Complete:
+			logEntry.Entry = fmt.Sprintf("Completed %s hook: %s", he.Phase, he.Command)
+		case Failed:
+			logEntry.Entry = fmt.Sprintf("Failed %s hook: %s", he.Phase, he.Command)
+		default:
+		}
+	case *proto.Event_WarningEvent:
+		we := e.WarningEvent
+		ev.stateLock.Lock()
+		ev.state.Warnings = append(ev.state.Warnings, we)
+		ev.stateChanged(warningsField)
+		ev.stateLock.Unlock()
+		logEntry.Entry = we.Message
 	case *proto.Event_PortEvent:
 		pe := e.PortEvent
 		ev.stateLock.Lock()
 		ev.state.ForwardedPorts[pe.LocalPort] = pe
+		ev.stateChanged(forwardedPortsField)
 		ev.stateLock.Unlock()
 		logEntry.Entry = fmt.Sprintf("Forwarding container %s to local port %d", pe.ContainerName, pe.LocalPort)
+	case *proto.Event_PortForwardTerminatedEvent:
+		te := e.PortForwardTerminatedEvent
+		ev.stateLock.Lock()
+		// The port might already be forwarded to a replacement pod.
+		if pe, found := ev.state.ForwardedPorts[te.LocalPort]; found && pe.PodName == te.PodName {
+			delete(ev.state.ForwardedPorts, te.LocalPort)
+			ev.stateChanged(forwardedPortsField)
+		}
+		ev.stateLock.Unlock()
+		logEntry.Entry = fmt.Sprintf("Stopped forwarding container %s to local port %d, pod %s is gone", te.ContainerName, te.LocalPort, te.PodName)
 	case *proto.Event_StatusCheckEvent:
 		se := e.StatusCheckEvent
 		ev.stateLock.Lock()
-		ev.state.StatusCheckState.Status = se.Status
+		if se.Status == InProgress {
+			ev.updateStatusCheckProgress(se)
+		} else {
+			ev.state.StatusCheckState.Status = se.Status
+		}
+		if se.Status == Cancelled {
+			for name, status := range ev.state.StatusCheckState.Resources {
+				if status == InProgress {
+					ev.state.StatusCheckState.Resources[name] = Cancelled
+				}
+			}
+		}
+		ev.stateChanged(statusCheckStateField)
 		ev.stateLock.Unlock()
 		switch se.Status {
 		case Started:
 			logEntry.Entry = "Status check started"
 		case InProgress:
-			logEntry.Entry = "Status check in progress"
+			logEntry.Entry = fmt.Sprintf("Status check in progress: %d/%d resource(s) ready", se.ReadyResources, se.TotalResources)
 		case Succeeded:
 			logEntry.Entry = "Status check succeeded"
 		case Failed:
 			logEntry.Entry = "Status check failed"
+		case Cancelled:
+			logEntry.Entry = "Status check cancelled"
 		default:
 		}
+	case *proto.Event_StatusCheckSummaryEvent:
+		se := e.StatusCheckSummaryEvent
+		ev.stateLock.Lock()
+		ev.state.StatusCheckState.Summary = se
+		ev.stateChanged(statusCheckStateField)
+		ev.stateLock.Unlock()
+		succeeded := 0
+		for _, r := range se.Resources {
+			if r.Status == Succeeded {
+				succeeded++
+			}
+		}
+		logEntry.Entry = fmt.Sprintf("Status check completed: %d/%d resource(s) stabilized", succeeded, len(se.Resources))
 	case *proto.Event_ResourceStatusCheckEvent:
 		rse := e.ResourceStatusCheckEvent
 		rseName := rse.Resource
 		ev.stateLock.Lock()
 		ev.state.StatusCheckState.Resources[rseName] = rse.Status
+		ev.stateChanged(statusCheckStateField)
 		ev.stateLock.Unlock()
 		switch rse.Status {
 		case InProgress:
@@ -390,21 +1057,85 @@ func (ev *eventHandler) handle(event *proto.Event) {
 	ev.logEvent(*logEntry)
 }
 
-// ResetStateOnBuild resets the build, deploy and sync state
+func (ev *eventHandler) handleKubeContextDeployEvent(de *proto.DeployEvent, logEntry *proto.LogEntry) {
+	ev.stateLock.Lock()
+	if ev.state.DeployState.KubeContexts == nil {
+		ev.state.DeployState.KubeContexts = map[string]string{}
+	}
+	ev.state.DeployState.KubeContexts[de.KubeContext] = de.Status
+	ev.stateChanged(deployStateField)
+	ev.stateLock.Unlock()
+	switch de.Status {
+	case InProgress:
+		logEntry.Entry = fmt.Sprintf("Deploy to kube-context %s started", de.KubeContext)
+	case Complete:
+		logEntry.Entry = fmt.Sprintf("Deploy to kube-context %s complete", de.KubeContext)
+	case Failed:
+		logEntry.Entry = fmt.Sprintf("Deploy to kube-context %s failed", de.KubeContext)
+	default:
+	}
+}
+
+// handleTestCommandEvent logs the progress and the output of a test command.
+// The test state only tracks the status of the tests of each artifact.
+func handleTestCommandEvent(te *proto.TestEvent, logEntry *proto.LogEntry) {
+	switch te.Status {
+	case InProgress:
+		logEntry.Entry = fmt.Sprintf("Running test command for artifact %s: %s", te.Artifact, te.Command)
+	case Complete:
+		logEntry.Entry = fmt.Sprintf("Test command completed for artifact %s: %s", te.Artifact, te.Command)
+	case Failed:
+		logEntry.Entry = fmt.Sprintf("Test command failed for artifact %s: %s", te.Artifact, te.Command)
+	case Info:
+		logEntry.Entry = te.Output
+	default:
+	}
+}
+
+// ResetStateOnBuild resets the build, test, deploy and sync state.
+// The warnings accumulated during the previous dev loop are cleared.
+// The durations of the last builds are kept.
 func ResetStateOnBuild() {
+	currentState := handler.getState()
 	builds := map[string]string{}
-	for k := range handler.getState().BuildState.Artifacts {
+	for k := range currentState.BuildState.Artifacts {
 		builds[k] = NotStarted
 	}
 	newState := emptyStateWithArtifacts(builds)
+	for k, d := range currentState.BuildState.Durations {
+		newState.BuildState.Durations[k] = d
+	}
 	handler.setState(newState)
 }
 
-// ResetStateOnDeploy resets the deploy, sync and status check state
+// ResetStateOnDeploy resets the deploy, sync, status check and port forwarding state.
+// It should be called before a one-off deploy.
 func ResetStateOnDeploy() {
+	resetStateOnDeploy(false)
+}
+
+// ResetStateOnRedeploy resets the deploy, sync and status check state
+// but keeps the forwarded ports. It should be called before each redeploy
+// in dev mode, where the ports are forwarded again to the same local ports.
+func ResetStateOnRedeploy() {
+	resetStateOnDeploy(true)
+}
+
+func resetStateOnDeploy(keepForwardedPorts bool) {
 	newState := handler.getState()
 	newState.DeployState.Status = NotStarted
+	newState.DeployState.RollbackStatus = ""
+	newState.DeployState.Timings = nil
+	for kubeContext := range newState.DeployState.KubeContexts {
+		newState.DeployState.KubeContexts[kubeContext] = NotStarted
+	}
 	newState.StatusCheckState.Status = NotStarted
-	newState.ForwardedPorts = map[int32]*proto.PortEvent{}
+	newState.StatusCheckState.Resources = map[string]string{}
+	newState.StatusCheckState.TotalResources = 0
+	newState.StatusCheckState.ReadyResources = 0
+	newState.StatusCheckState.Percentage = 0
+	if !keepForwardedPorts {
+		newState.ForwardedPorts = map[int32]*proto.PortEvent{}
+	}
 	handler.setState(newState)
 }
@@ -18,10 +18,15 @@ package event
 
 import (
 	"errors"
+	"sort"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/proto"
 	"github.com/GoogleContainerTools/skaffold/testutil"
@@ -53,6 +58,72 @@ func TestGetLogEvents(t *testing.T) {
 	}
 }
 
+func TestForEachEventMultipleSubscribers(t *testing.T) {
+	ev := &eventHandler{}
+	ev.logEvent(proto.LogEntry{Entry: "OLD"})
+
+	var wg sync.WaitGroup
+	received := make([][]string, 2)
+	for i := range received {
+		listener, oldEvents := ev.subscribe()
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer ev.unsubscribe(listener)
+
+			for _, e := range oldEvents {
+				received[i] = append(received[i], e.Entry)
+			}
+			for e := range listener.entries {
+				received[i] = append(received[i], e.Entry)
+				if e.Entry == "LAST" {
+					return
+				}
+			}
+		}(i)
+	}
+
+	ev.logEvent(proto.LogEntry{Entry: "FRESH"})
+	ev.logEvent(proto.LogEntry{Entry: "LAST"})
+	wg.Wait()
+
+	expected := []string{"OLD", "FRESH", "LAST"}
+	testutil.CheckDeepEqual(t, [][]string{expected, expected}, received)
+}
+
+func TestForEachEventDroppedEntries(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&listenerBufferSize, 1)
+
+		ev := &eventHandler{}
+		listener, _ := ev.subscribe()
+
+		ev.logEvent(proto.LogEntry{Entry: "FIRST"})
+		ev.logEvent(proto.LogEntry{Entry: "DROPPED1"})
+		ev.logEvent(proto.LogEntry{Entry: "DROPPED2"})
+		t.CheckDeepEqual("FIRST", (<-listener.entries).Entry)
+
+		ev.logEvent(proto.LogEntry{Entry: "NEXT"})
+		next := <-listener.entries
+		t.CheckDeepEqual("NEXT", next.Entry)
+		t.CheckDeepEqual(int64(2), next.DroppedEntries)
+	})
+}
+
+func TestEventLogSize(t *testing.T) {
+	ev := &eventHandler{logSize: 2}
+	ev.logEvent(proto.LogEntry{Entry: "1"})
+	ev.logEvent(proto.LogEntry{Entry: "2"})
+	ev.logEvent(proto.LogEntry{Entry: "3"})
+
+	var entries []string
+	for _, e := range ev.eventLog.list() {
+		entries = append(entries, e.Entry)
+	}
+
+	testutil.CheckDeepEqual(t, []string{"2", "3"}, entries)
+}
+
 func TestGetState(t *testing.T) {
 	ev := &eventHandler{
 		state: emptyState(latest.BuildConfig{}),
@@ -103,6 +174,92 @@ func TestDeployComplete(t *testing.T) {
 	wait(t, func() bool { return handler.getState().DeployState.Status == Complete })
 }
 
+func TestDeploySkipped(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{}),
+	}
+
+	wait(t, func() bool { return handler.getState().DeployState.Status == NotStarted })
+	DeploySkipped()
+	wait(t, func() bool { return handler.getState().DeployState.Status == Skipped })
+}
+
+func TestDeployRollback(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{}),
+	}
+
+	DeployRollbackInProgress()
+	wait(t, func() bool { return handler.getState().DeployState.RollbackStatus == InProgress })
+	DeployRollbackComplete([]string{"test:deployment/dep"})
+	wait(t, func() bool { return handler.getState().DeployState.RollbackStatus == Complete })
+	DeployRollbackFailed(nil, errors.New("BUG"))
+	wait(t, func() bool { return handler.getState().DeployState.RollbackStatus == Failed })
+}
+
+func TestDeployHook(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{}),
+	}
+
+	DeployHookInProgress("pre-deploy", "./migrate.sh")
+	wait(t, func() bool { return lastLogEntry() == "Running pre-deploy hook: ./migrate.sh" })
+	DeployHookFailed("pre-deploy", "./migrate.sh", errors.New("BUG"))
+	wait(t, func() bool { return lastLogEntry() == "Failed pre-deploy hook: ./migrate.sh" })
+}
+
+func lastLogEntry() string {
+	handler.logLock.Lock()
+	defer handler.logLock.Unlock()
+
+	entries := handler.eventLog.list()
+	if len(entries) == 0 {
+		return ""
+	}
+	return entries[len(entries)-1].Entry
+}
+
+func TestWarn(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{}),
+	}
+
+	Warn(ImageMismatch, "IMAGE_NOT_USED", "image [img] is not used by the deployment")
+	Warn(DeprecatedField, "KANIKO_ADDITIONAL_FLAGS", "The additionalFlags field in kaniko is deprecated")
+	wait(t, func() bool { return len(handler.getState().Warnings) == 2 })
+
+	var codes []string
+	for _, w := range handler.getState().Warnings {
+		codes = append(codes, w.Code)
+	}
+	sort.Strings(codes)
+	testutil.CheckDeepEqual(t, []string{"IMAGE_NOT_USED", "KANIKO_ADDITIONAL_FLAGS"}, codes)
+
+	ResetStateOnBuild()
+	testutil.CheckDeepEqual(t, 0, len(handler.getState().Warnings))
+}
+
+func TestDeployRetry(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{}),
+	}
+
+	DeployInProgress()
+	wait(t, func() bool { return handler.getState().DeployState.Status == InProgress })
+	DeployRetry(1, errors.New("connection refused"))
+	wait(t, func() bool { return handler.getState().DeployState.Status == Retrying })
+}
+
 func TestBuildInProgress(t *testing.T) {
 	defer func() { handler = &eventHandler{} }()
 
@@ -147,8 +304,213 @@ func TestBuildComplete(t *testing.T) {
 	}
 
 	wait(t, func() bool { return handler.getState().BuildState.Artifacts["img"] == NotStarted })
-	BuildComplete("img")
+	BuildComplete("img", "img:tag@sha256:abacabac")
 	wait(t, func() bool { return handler.getState().BuildState.Artifacts["img"] == Complete })
+	testutil.CheckDeepEqual(t, "img:tag@sha256:abacabac", handler.getState().BuildState.Images["img"])
+}
+
+func TestBuildCacheHit(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{
+			Artifacts: []*latest.Artifact{{
+				ImageName: "img",
+			}},
+		}),
+	}
+
+	wait(t, func() bool { return handler.getState().BuildState.Artifacts["img"] == NotStarted })
+	BuildCacheHit("img", "img:tag@sha256:abacabac")
+	wait(t, func() bool { return handler.getState().BuildState.Artifacts["img"] == Complete })
+	testutil.CheckDeepEqual(t, "img:tag@sha256:abacabac", handler.getState().BuildState.Images["img"])
+	testutil.CheckDeepEqual(t, true, handler.getState().BuildState.Cached["img"])
+
+	// A later build isn't cached anymore.
+	BuildInProgress("img")
+	wait(t, func() bool { return handler.getState().BuildState.Artifacts["img"] == InProgress })
+	testutil.CheckDeepEqual(t, false, handler.getState().BuildState.Cached["img"])
+}
+
+func TestImagePushProgress(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{}),
+	}
+
+	pushProgressEvents := func() []*proto.ImagePushProgressEvent {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+
+		var events []*proto.ImagePushProgressEvent
+		for _, entry := range handler.eventLog.list() {
+			if pe := entry.Event.GetImagePushProgressEvent(); pe != nil {
+				events = append(events, pe)
+			}
+		}
+		return events
+	}
+
+	// Rapid updates are coalesced, except for the last one.
+	ImagePushProgress("img:tag", "layer1", 10, 100)
+	ImagePushProgress("img:tag", "layer1", 20, 100)
+	ImagePushProgress("img:tag", "layer1", 30, 100)
+	ImagePushProgress("img:tag", "layer2", 5, 50)
+	ImagePushProgress("img:tag", "layer1", 100, 100)
+
+	wait(t, func() bool { return len(pushProgressEvents()) == 3 })
+	time.Sleep(50 * time.Millisecond)
+
+	events := pushProgressEvents()
+	testutil.CheckDeepEqual(t, 3, len(events))
+	pushed := map[string][]int64{}
+	for _, pe := range events {
+		pushed[pe.Layer] = append(pushed[pe.Layer], pe.BytesPushed)
+	}
+	testutil.CheckDeepEqual(t, 2, len(pushed["layer1"]))
+	testutil.CheckDeepEqual(t, []int64{5}, pushed["layer2"])
+}
+
+func TestFileChanged(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{}),
+	}
+
+	FileChanged([]string{"main.go", "util.go"}, []string{"img"})
+
+	var entry proto.LogEntry
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		entries := handler.eventLog.list()
+		if len(entries) == 0 {
+			return false
+		}
+		entry = entries[0]
+		return true
+	})
+	testutil.CheckDeepEqual(t, []string{"main.go", "util.go"}, entry.Event.GetFileChangedEvent().Paths)
+	testutil.CheckDeepEqual(t, []string{"img"}, entry.Event.GetFileChangedEvent().Artifacts)
+	testutil.CheckDeepEqual(t, "2 file(s) changed for artifact(s) img", entry.Entry)
+}
+
+func TestTestCommandOutput(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{Artifacts: []*latest.Artifact{{ImageName: "img"}}}),
+	}
+
+	TestCommandOutput("img", "./smoke-test.sh", "OK")
+
+	handler.logLock.Lock()
+	entries := handler.eventLog.list()
+	handler.logLock.Unlock()
+	testutil.CheckDeepEqual(t, 1, len(entries))
+	testutil.CheckDeepEqual(t, "OK", entries[0].Entry)
+	testutil.CheckDeepEqual(t, NotStarted, handler.getState().TestState.Artifacts["img"])
+}
+
+func TestRunComplete(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{}),
+	}
+
+	testutil.CheckDeepEqual(t, "", handler.getState().RunStatus)
+
+	RunComplete(map[string]string{"img": "img:tag"}, nil, errors.New("deploy failed"))
+
+	var entry proto.LogEntry
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		entries := handler.eventLog.list()
+		if len(entries) == 0 {
+			return false
+		}
+		entry = entries[0]
+		return true
+	})
+	testutil.CheckDeepEqual(t, Failed, handler.getState().RunStatus)
+	testutil.CheckDeepEqual(t, "Run failed", entry.Entry)
+	testutil.CheckDeepEqual(t, "deploy failed", entry.Event.GetRunCompleteEvent().Err)
+	testutil.CheckDeepEqual(t, map[string]string{"img": "img:tag"}, entry.Event.GetRunCompleteEvent().Images)
+}
+
+func TestBuildDuration(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{
+			Artifacts: []*latest.Artifact{{
+				ImageName: "img",
+			}},
+		}),
+	}
+
+	BuildInProgress("img")
+	time.Sleep(10 * time.Millisecond)
+	BuildComplete("img", "img:tag")
+	wait(t, func() bool { return handler.getState().BuildState.Durations["img"] != nil })
+
+	duration, err := ptypes.Duration(handler.getState().BuildState.Durations["img"])
+	testutil.CheckError(t, false, err)
+	if duration < 10*time.Millisecond {
+		t.Errorf("expected a build duration of at least 10ms, got %v", duration)
+	}
+}
+
+func TestTestInProgress(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{
+			Artifacts: []*latest.Artifact{{
+				ImageName: "img",
+			}},
+		}),
+	}
+
+	wait(t, func() bool { return handler.getState().TestState.Artifacts["img"] == NotStarted })
+	TestInProgress("img")
+	wait(t, func() bool { return handler.getState().TestState.Artifacts["img"] == InProgress })
+}
+
+func TestTestFailed(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{
+			Artifacts: []*latest.Artifact{{
+				ImageName: "img",
+			}},
+		}),
+	}
+
+	wait(t, func() bool { return handler.getState().TestState.Artifacts["img"] == NotStarted })
+	TestFailed("img", errors.New("BUG"))
+	wait(t, func() bool { return handler.getState().TestState.Artifacts["img"] == Failed })
+}
+
+func TestTestComplete(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{
+			Artifacts: []*latest.Artifact{{
+				ImageName: "img",
+			}},
+		}),
+	}
+
+	wait(t, func() bool { return handler.getState().TestState.Artifacts["img"] == NotStarted })
+	TestComplete("img")
+	wait(t, func() bool { return handler.getState().TestState.Artifacts["img"] == Complete })
 }
 
 func TestPortForwarded(t *testing.T) {
@@ -159,8 +521,53 @@ func TestPortForwarded(t *testing.T) {
 	}
 
 	wait(t, func() bool { return handler.getState().ForwardedPorts[8080] == nil })
-	PortForwarded(8080, 8888, "pod", "container", "ns", "portname", "resourceType", "resourceName")
+	PortForwarded(8080, 8888, "pod", "container", "ns", "portname", "resourceType", "resourceName", "")
 	wait(t, func() bool { return handler.getState().ForwardedPorts[8080] != nil })
+	testutil.CheckDeepEqual(t, "TCP", handler.getState().ForwardedPorts[8080].Protocol)
+
+	PortForwarded(8081, 53, "pod", "container", "ns", "dns", "resourceType", "resourceName", "UDP")
+	wait(t, func() bool { return handler.getState().ForwardedPorts[8081] != nil })
+	testutil.CheckDeepEqual(t, "UDP", handler.getState().ForwardedPorts[8081].Protocol)
+}
+
+func TestPortForwardTerminated(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{}),
+	}
+
+	PortForwarded(8080, 8888, "pod1", "container", "ns", "portname", "pod", "pod1", "")
+	wait(t, func() bool { return handler.getState().ForwardedPorts[8080] != nil })
+
+	// A port forwarded to a replacement pod is kept.
+	handler.handle(&proto.Event{
+		EventType: &proto.Event_PortForwardTerminatedEvent{
+			PortForwardTerminatedEvent: &proto.PortForwardTerminatedEvent{LocalPort: 8080, PodName: "pod0"},
+		},
+	})
+	testutil.CheckDeepEqual(t, "pod1", handler.getState().ForwardedPorts[8080].PodName)
+
+	PortForwardTerminated(8080, 8888, "pod1", "container", "ns", "portname")
+	wait(t, func() bool { return handler.getState().ForwardedPorts[8080] == nil })
+}
+
+func TestDeployToKubeContext(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{}),
+	}
+
+	DeployToKubeContextComplete("cluster1")
+	DeployToKubeContextFailed("cluster2", errors.New("deploy error"))
+	wait(t, func() bool { return len(handler.getState().DeployState.KubeContexts) == 2 })
+
+	testutil.CheckDeepEqual(t, map[string]string{"cluster1": Complete, "cluster2": Failed}, handler.getState().DeployState.KubeContexts)
+	testutil.CheckDeepEqual(t, NotStarted, handler.getState().DeployState.Status)
+
+	ResetStateOnDeploy()
+	testutil.CheckDeepEqual(t, map[string]string{"cluster1": NotStarted, "cluster2": NotStarted}, handler.getState().DeployState.KubeContexts)
 }
 
 func TestStatusCheckEventStarted(t *testing.T) {
@@ -183,8 +590,111 @@ func TestStatusCheckEventInProgress(t *testing.T) {
 	}
 
 	wait(t, func() bool { return handler.getState().StatusCheckState.Status == NotStarted })
-	StatusCheckEventInProgress("[2/5 deployment(s) are still pending]")
+	StatusCheckEventInProgress(2, 5)
 	wait(t, func() bool { return handler.getState().StatusCheckState.Status == InProgress })
+
+	state := handler.getState().StatusCheckState
+	testutil.CheckDeepEqual(t, int32(5), state.TotalResources)
+	testutil.CheckDeepEqual(t, int32(2), state.ReadyResources)
+	testutil.CheckDeepEqual(t, int32(40), state.Percentage)
+}
+
+func TestStatusCheckProgress(t *testing.T) {
+	tests := []struct {
+		description        string
+		initial            proto.StatusCheckState
+		event              proto.StatusCheckEvent
+		expectedStatus     string
+		expectedReady      int32
+		expectedPercentage int32
+	}{
+		{
+			description:        "first progress",
+			initial:            proto.StatusCheckState{Status: Started},
+			event:              proto.StatusCheckEvent{Status: InProgress, TotalResources: 4, ReadyResources: 1},
+			expectedStatus:     InProgress,
+			expectedReady:      1,
+			expectedPercentage: 25,
+		},
+		{
+			description:        "out of order progress",
+			initial:            proto.StatusCheckState{Status: InProgress, TotalResources: 4, ReadyResources: 3},
+			event:              proto.StatusCheckEvent{Status: InProgress, TotalResources: 4, ReadyResources: 2},
+			expectedStatus:     InProgress,
+			expectedReady:      3,
+			expectedPercentage: 75,
+		},
+		{
+			description:        "new status check",
+			initial:            proto.StatusCheckState{Status: InProgress, TotalResources: 4, ReadyResources: 3},
+			event:              proto.StatusCheckEvent{Status: InProgress, TotalResources: 2, ReadyResources: 0},
+			expectedStatus:     InProgress,
+			expectedReady:      0,
+			expectedPercentage: 0,
+		},
+		{
+			description:        "progress after completion",
+			initial:            proto.StatusCheckState{Status: Succeeded, TotalResources: 2, ReadyResources: 1},
+			event:              proto.StatusCheckEvent{Status: InProgress, TotalResources: 2, ReadyResources: 2},
+			expectedStatus:     Succeeded,
+			expectedReady:      2,
+			expectedPercentage: 100,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			ev := &eventHandler{
+				state: proto.State{StatusCheckState: &test.initial},
+			}
+
+			ev.updateStatusCheckProgress(&test.event)
+
+			state := ev.state.StatusCheckState
+			t.CheckDeepEqual(test.expectedStatus, state.Status)
+			t.CheckDeepEqual(test.expectedReady, state.ReadyResources)
+			t.CheckDeepEqual(test.expectedPercentage, state.Percentage)
+		})
+	}
+}
+
+func TestDeployTimings(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{}),
+	}
+
+	DeployPhaseCompleted(RenderPhase, time.Second)
+	DeployPhaseCompleted(ApplyPhase, 2*time.Second)
+	DeployPhaseCompleted(RenderPhase, 500*time.Millisecond)
+	DeployPhaseCompleted("unknown", time.Minute)
+	DeployComplete()
+
+	var completed *proto.DeployEvent
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		defer handler.logLock.Unlock()
+		for _, entry := range handler.eventLog.list() {
+			if de := entry.Event.GetDeployEvent(); de != nil && de.Status == Complete {
+				completed = de
+				return true
+			}
+		}
+		return false
+	})
+	DeployPhaseCompleted(StatusCheckPhase, 3*time.Second)
+
+	timings := handler.getState().DeployState.Timings
+	testutil.CheckDeepEqual(t, &proto.DeployTimings{
+		Render:      ptypes.DurationProto(1500 * time.Millisecond),
+		Apply:       ptypes.DurationProto(2 * time.Second),
+		StatusCheck: ptypes.DurationProto(3 * time.Second),
+	}, timings)
+
+	testutil.CheckDeepEqual(t, &proto.DeployTimings{
+		Render: ptypes.DurationProto(1500 * time.Millisecond),
+		Apply:  ptypes.DurationProto(2 * time.Second),
+	}, completed.Timings)
 }
 
 func TestStatusCheckEventSucceeded(t *testing.T) {
@@ -211,6 +721,25 @@ func TestStatusCheckEventFailed(t *testing.T) {
 	wait(t, func() bool { return handler.getState().StatusCheckState.Status == Failed })
 }
 
+func TestStatusCheckEventCancelled(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{}),
+	}
+
+	ResourceStatusCheckEventSucceeded("ns:deployment/ready")
+	ResourceStatusCheckEventUpdated("ns:deployment/pending", "waiting for rollout")
+	wait(t, func() bool { return len(handler.getState().StatusCheckState.Resources) == 2 })
+
+	StatusCheckEventCancelled()
+	wait(t, func() bool { return handler.getState().StatusCheckState.Status == Cancelled })
+	testutil.CheckDeepEqual(t, map[string]string{
+		"ns:deployment/ready":   Succeeded,
+		"ns:deployment/pending": Cancelled,
+	}, handler.getState().StatusCheckState.Resources)
+}
+
 func TestResourceStatusCheckEventUpdated(t *testing.T) {
 	defer func() { handler = &eventHandler{} }()
 
@@ -275,6 +804,14 @@ func TestResetStateOnBuild(t *testing.T) {
 				Artifacts: map[string]string{
 					"image1": Complete,
 				},
+				Durations: map[string]*duration.Duration{
+					"image1": {Seconds: 10},
+				},
+			},
+			TestState: &proto.TestState{
+				Artifacts: map[string]string{
+					"image1": Failed,
+				},
 			},
 			DeployState: &proto.DeployState{Status: Complete},
 			ForwardedPorts: map[int32]*proto.PortEvent{
@@ -293,6 +830,14 @@ func TestResetStateOnBuild(t *testing.T) {
 			Artifacts: map[string]string{
 				"image1": NotStarted,
 			},
+			Durations: map[string]*duration.Duration{
+				"image1": {Seconds: 10},
+			},
+		},
+		TestState: &proto.TestState{
+			Artifacts: map[string]string{
+				"image1": NotStarted,
+			},
 		},
 		DeployState:      &proto.DeployState{Status: NotStarted},
 		StatusCheckState: &proto.StatusCheckState{Status: NotStarted},
@@ -309,7 +854,10 @@ func TestResetStateOnDeploy(t *testing.T) {
 					"image1": Complete,
 				},
 			},
-			DeployState: &proto.DeployState{Status: Complete},
+			DeployState: &proto.DeployState{
+				Status:  Complete,
+				Timings: &proto.DeployTimings{Apply: ptypes.DurationProto(time.Second)},
+			},
 			ForwardedPorts: map[int32]*proto.PortEvent{
 				2001: {
 					LocalPort:  2000,
@@ -332,3 +880,84 @@ func TestResetStateOnDeploy(t *testing.T) {
 	}
 	testutil.CheckDeepEqual(t, expected, handler.getState())
 }
+
+func TestResetStateOnRedeploy(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+	handler = &eventHandler{
+		state: proto.State{
+			BuildState: &proto.BuildState{
+				Artifacts: map[string]string{
+					"image1": Complete,
+				},
+			},
+			DeployState: &proto.DeployState{Status: Complete},
+			ForwardedPorts: map[int32]*proto.PortEvent{
+				2001: {
+					LocalPort:  2000,
+					RemotePort: 2001,
+					PodName:    "test/pod",
+				},
+			},
+			StatusCheckState: &proto.StatusCheckState{Status: Complete},
+		},
+	}
+	ResetStateOnRedeploy()
+	expected := proto.State{
+		BuildState: &proto.BuildState{
+			Artifacts: map[string]string{
+				"image1": Complete,
+			},
+		},
+		DeployState: &proto.DeployState{Status: NotStarted},
+		ForwardedPorts: map[int32]*proto.PortEvent{
+			2001: {
+				LocalPort:  2000,
+				RemotePort: 2001,
+				PodName:    "test/pod",
+			},
+		},
+		StatusCheckState: &proto.StatusCheckState{Status: NotStarted},
+	}
+	testutil.CheckDeepEqual(t, expected, handler.getState())
+}
+
+func TestStatusCheckSummary(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+
+	handler = &eventHandler{
+		state: emptyState(latest.BuildConfig{}),
+	}
+
+	StatusCheckSummary(&proto.StatusCheckSummaryEvent{
+		Resources: []*proto.ResourceStatusCheckSummary{
+			{Resource: "test:deployment/dep1", Status: Succeeded},
+			{Resource: "test:deployment/dep2", Status: Failed, Err: "could not stabilize"},
+		},
+	})
+	wait(t, func() bool { return handler.getState().StatusCheckState.Summary != nil })
+	testutil.CheckDeepEqual(t, 2, len(handler.getState().StatusCheckState.Summary.Resources))
+	wait(t, func() bool { return lastLogEntry() == "Status check completed: 1/2 resource(s) stabilized" })
+}
+
+func TestResetStateOnDeployAfterCancelledStatusCheck(t *testing.T) {
+	defer func() { handler = &eventHandler{} }()
+	handler = &eventHandler{
+		state: proto.State{
+			BuildState:  &proto.BuildState{},
+			DeployState: &proto.DeployState{Status: Complete},
+			StatusCheckState: &proto.StatusCheckState{
+				Status: Cancelled,
+				Resources: map[string]string{
+					"ns:deployment/pending": Cancelled,
+				},
+			},
+		},
+	}
+
+	ResetStateOnDeploy()
+	testutil.CheckDeepEqual(t, &proto.StatusCheckState{Status: NotStarted}, handler.getState().StatusCheckState)
+
+	// New status checks can be recorded after the reset.
+	ResourceStatusCheckEventUpdated("ns:deployment/new", "waiting for rollout")
+	wait(t, func() bool { return handler.getState().StatusCheckState.Resources["ns:deployment/new"] == InProgress })
+}
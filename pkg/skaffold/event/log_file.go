@@ -0,0 +1,105 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"bufio"
+	"os"
+	"sync"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+type logFile struct {
+	file     *os.File
+	handler  *eventHandler
+	listener *listener
+	done     chan struct{}
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// LogEventsToFile writes every log entry, as newline-delimited JSON, to the given file.
+// Writing to the file never blocks the event log: if the file can't keep up,
+// entries are dropped and the number of dropped entries is written
+// with the next entry.
+// It returns a callback that flushes and closes the file.
+func LogEventsToFile(filename string) (func() error, error) {
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, oldEntries := handler.subscribe()
+	f := &logFile{
+		file:     file,
+		handler:  handler,
+		listener: listener,
+		done:     make(chan struct{}),
+	}
+
+	go f.write(oldEntries)
+
+	return f.close, nil
+}
+
+func (f *logFile) write(oldEntries []proto.LogEntry) {
+	defer close(f.done)
+
+	w := bufio.NewWriter(f.file)
+	marshaler := jsonpb.Marshaler{}
+
+	writeEntry := func(entry *proto.LogEntry) {
+		if err := marshaler.Marshal(w, entry); err != nil {
+			logrus.Warnf("unable to write event to log file: %s", err)
+			return
+		}
+		w.WriteString("\n")
+	}
+
+	for i := range oldEntries {
+		writeEntry(&oldEntries[i])
+	}
+
+	for entry := range f.listener.entries {
+		writeEntry(entry)
+
+		// Flush when there's nothing left to write so that the file is usable while skaffold runs.
+		if len(f.listener.entries) == 0 {
+			if err := w.Flush(); err != nil {
+				logrus.Warnf("unable to flush event log file: %s", err)
+			}
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		logrus.Warnf("unable to flush event log file: %s", err)
+	}
+}
+
+func (f *logFile) close() error {
+	f.closeOnce.Do(func() {
+		f.handler.unsubscribe(f.listener)
+		<-f.done
+		f.closeErr = f.file.Close()
+	})
+	return f.closeErr
+}
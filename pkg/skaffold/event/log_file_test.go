@@ -0,0 +1,54 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestLogEventsToFile(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&handler, &eventHandler{})
+		filename := t.NewTempDir().Path("events.json")
+
+		handler.logEvent(proto.LogEntry{Entry: "OLD"})
+		closeLogFile, err := LogEventsToFile(filename)
+		t.CheckNoError(err)
+		handler.logEvent(proto.LogEntry{Entry: "NEW1"})
+		handler.logEvent(proto.LogEntry{Entry: "NEW2"})
+		t.CheckNoError(closeLogFile())
+		handler.logEvent(proto.LogEntry{Entry: "AFTER CLOSE"})
+
+		content, err := ioutil.ReadFile(filename)
+		t.CheckNoError(err)
+
+		var entries []string
+		for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+			var entry proto.LogEntry
+			t.CheckNoError(jsonpb.UnmarshalString(line, &entry))
+			entries = append(entries, entry.Entry)
+		}
+		t.CheckDeepEqual([]string{"OLD", "NEW1", "NEW2"}, entries)
+	})
+}
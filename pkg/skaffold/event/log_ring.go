@@ -0,0 +1,46 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// logRing keeps the most recent log entries.
+type logRing struct {
+	entries []proto.LogEntry
+	// next is the position of the oldest entry, that will be overwritten next,
+	// once the ring is full.
+	next int
+}
+
+func (r *logRing) add(entry proto.LogEntry, size int) {
+	if len(r.entries) < size {
+		r.entries = append(r.entries, entry)
+		return
+	}
+
+	r.entries[r.next] = entry
+	r.next = (r.next + 1) % len(r.entries)
+}
+
+// list returns a copy of the entries, from the oldest to the most recent.
+func (r *logRing) list() []proto.LogEntry {
+	list := make([]proto.LogEntry, 0, len(r.entries))
+	list = append(list, r.entries[r.next:]...)
+	return append(list, r.entries[:r.next]...)
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	protobuf "github.com/golang/protobuf/proto"
+
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// Names of the sub-states, as listed in state changes.
+const (
+	buildStateField       = "buildState"
+	testStateField        = "testState"
+	deployStateField      = "deployState"
+	forwardedPortsField   = "forwardedPorts"
+	statusCheckStateField = "statusCheckState"
+	warningsField         = "warnings"
+	runStatusField        = "runStatus"
+)
+
+var allStateFields = []string{
+	buildStateField,
+	testStateField,
+	deployStateField,
+	forwardedPortsField,
+	statusCheckStateField,
+	warningsField,
+	runStatusField,
+}
+
+// stateListener receives the changes of the state made after it subscribed.
+type stateListener struct {
+	changes chan *proto.StateChangedEvent
+	// missedChanges is true if a change couldn't be sent. The next change
+	// then carries the whole state so that the listener can catch up.
+	missedChanges bool
+}
+
+// ForEachStateChange sends the current state and then each change
+// of the state, until the callback returns an error.
+func ForEachStateChange(callback func(*proto.StateChangedEvent) error) error {
+	return handler.forEachStateChange(callback)
+}
+
+func (ev *eventHandler) forEachStateChange(callback func(*proto.StateChangedEvent) error) error {
+	listener, current := ev.subscribeToState()
+	defer ev.unsubscribeFromState(listener)
+
+	if err := callback(current); err != nil {
+		return err
+	}
+
+	for change := range listener.changes {
+		if err := callback(change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// subscribeToState registers a new listener and returns the current state,
+// as a change of all the sub-states.
+func (ev *eventHandler) subscribeToState() (*stateListener, *proto.StateChangedEvent) {
+	listener := &stateListener{
+		changes: make(chan *proto.StateChangedEvent, listenerBufferSize),
+	}
+
+	ev.stateLock.Lock()
+	current := ev.stateChange(allStateFields)
+	ev.stateListeners = append(ev.stateListeners, listener)
+	ev.stateLock.Unlock()
+
+	return listener, current
+}
+
+// unsubscribeFromState stops sending changes to a listener.
+func (ev *eventHandler) unsubscribeFromState(listener *stateListener) {
+	ev.stateLock.Lock()
+	defer ev.stateLock.Unlock()
+
+	for i, l := range ev.stateListeners {
+		if l == listener {
+			ev.stateListeners = append(ev.stateListeners[:i], ev.stateListeners[i+1:]...)
+			close(listener.changes)
+			return
+		}
+	}
+}
+
+// stateChanged bumps the revision of the state and notifies the listeners
+// of the sub-states that changed. It must be called with stateLock held.
+func (ev *eventHandler) stateChanged(fields ...string) {
+	ev.revision++
+	if len(ev.stateListeners) == 0 {
+		return
+	}
+
+	change := ev.stateChange(fields)
+	var all *proto.StateChangedEvent
+
+	for _, listener := range ev.stateListeners {
+		sent := change
+		if listener.missedChanges {
+			if all == nil {
+				all = ev.stateChange(allStateFields)
+			}
+			sent = all
+		}
+
+		// Never block on a slow listener
+		select {
+		case listener.changes <- sent:
+			listener.missedChanges = false
+		default:
+			listener.missedChanges = true
+		}
+	}
+}
+
+// stateChange copies the given sub-states of the current state.
+// It must be called with stateLock held.
+func (ev *eventHandler) stateChange(fields []string) *proto.StateChangedEvent {
+	state := &proto.State{}
+	for _, field := range fields {
+		switch field {
+		case buildStateField:
+			state.BuildState = ev.state.BuildState
+		case testStateField:
+			state.TestState = ev.state.TestState
+		case deployStateField:
+			state.DeployState = ev.state.DeployState
+		case forwardedPortsField:
+			state.ForwardedPorts = ev.state.ForwardedPorts
+		case statusCheckStateField:
+			state.StatusCheckState = ev.state.StatusCheckState
+		case warningsField:
+			state.Warnings = ev.state.Warnings
+		case runStatusField:
+			state.RunStatus = ev.state.RunStatus
+		}
+	}
+
+	return &proto.StateChangedEvent{
+		Revision: ev.revision,
+		State:    protobuf.Clone(state).(*proto.State),
+		Changed:  fields,
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package event
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestStateChanges(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		ev := &eventHandler{
+			state: emptyState(latest.BuildConfig{
+				Artifacts: []*latest.Artifact{{ImageName: "img"}},
+			}),
+		}
+
+		listener, current := ev.subscribeToState()
+		defer ev.unsubscribeFromState(listener)
+		t.CheckDeepEqual(allStateFields, current.Changed)
+		t.CheckDeepEqual(NotStarted, current.State.BuildState.Artifacts["img"])
+
+		ev.handle(&proto.Event{EventType: &proto.Event_BuildEvent{BuildEvent: &proto.BuildEvent{Artifact: "img", Status: InProgress}}})
+		ev.handle(&proto.Event{EventType: &proto.Event_DeployEvent{DeployEvent: &proto.DeployEvent{Status: InProgress}}})
+
+		change := <-listener.changes
+		t.CheckDeepEqual(current.Revision+1, change.Revision)
+		t.CheckDeepEqual([]string{buildStateField}, change.Changed)
+		t.CheckDeepEqual(InProgress, change.State.BuildState.Artifacts["img"])
+		t.CheckDeepEqual((*proto.DeployState)(nil), change.State.DeployState)
+
+		change = <-listener.changes
+		t.CheckDeepEqual(current.Revision+2, change.Revision)
+		t.CheckDeepEqual([]string{deployStateField}, change.Changed)
+		t.CheckDeepEqual(InProgress, change.State.DeployState.Status)
+		t.CheckDeepEqual((*proto.BuildState)(nil), change.State.BuildState)
+	})
+}
+
+func TestStateChangesAfterMissedChanges(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&listenerBufferSize, 1)
+
+		ev := &eventHandler{
+			state: emptyState(latest.BuildConfig{}),
+		}
+
+		listener, _ := ev.subscribeToState()
+		defer ev.unsubscribeFromState(listener)
+
+		ev.handle(&proto.Event{EventType: &proto.Event_DeployEvent{DeployEvent: &proto.DeployEvent{Status: InProgress}}})
+		ev.handle(&proto.Event{EventType: &proto.Event_DeployEvent{DeployEvent: &proto.DeployEvent{Status: Complete}}})
+		t.CheckDeepEqual([]string{deployStateField}, (<-listener.changes).Changed)
+
+		// The second change was missed: the next one carries the whole state.
+		ev.handle(&proto.Event{EventType: &proto.Event_StatusCheckEvent{StatusCheckEvent: &proto.StatusCheckEvent{Status: Started}}})
+		change := <-listener.changes
+		t.CheckDeepEqual(allStateFields, change.Changed)
+		t.CheckDeepEqual(Complete, change.State.DeployState.Status)
+		t.CheckDeepEqual(Started, change.State.StatusCheckState.Status)
+	})
+}
@@ -29,6 +29,7 @@ import (
 // CLI holds parameters to run kubectl.
 type CLI struct {
 	KubeContext string
+	KubeConfig  string
 	Namespace   string
 
 	version     ClientVersion
@@ -38,6 +39,7 @@ type CLI struct {
 func NewFromRunContext(runCtx *runcontext.RunContext) *CLI {
 	return &CLI{
 		KubeContext: runCtx.KubeContext,
+		KubeConfig:  runCtx.Opts.KubeConfig,
 		Namespace:   runCtx.Opts.Namespace,
 	}
 }
@@ -79,9 +81,12 @@ func (c *CLI) RunOut(ctx context.Context, command string, arg ...string) ([]byte
 }
 
 // args builds an argument list for calling kubectl and consistently
-// adds the `--context` and `--namespace` flags.
+// adds the `--context`, `--kubeconfig` and `--namespace` flags.
 func (c *CLI) args(command string, namespace string, arg ...string) []string {
 	args := []string{"--context", c.KubeContext}
+	if c.KubeConfig != "" {
+		args = append(args, "--kubeconfig", c.KubeConfig)
+	}
 	namespace = c.resolveNamespace(namespace)
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
@@ -30,6 +30,7 @@ func TestCLI(t *testing.T) {
 	tests := []struct {
 		name            string
 		kubecontext     string
+		kubeconfig      string
 		namespace       string
 		output          string
 		expectedCommand string
@@ -47,6 +48,13 @@ func TestCLI(t *testing.T) {
 			output:          "this is the expected output",
 			expectedCommand: "kubectl --context some-kubecontext exec arg1 arg2",
 		},
+		{
+			name:            "with kubeconfig",
+			kubecontext:     "some-kubecontext",
+			kubeconfig:      "/path/to/kubeconfig",
+			output:          "this is the expected output",
+			expectedCommand: "kubectl --context some-kubecontext --kubeconfig /path/to/kubeconfig exec arg1 arg2",
+		},
 	}
 
 	// test cli.Run()
@@ -57,7 +65,7 @@ func TestCLI(t *testing.T) {
 			))
 
 			cli := NewFromRunContext(&runcontext.RunContext{
-				Opts:        config.SkaffoldOptions{Namespace: test.namespace},
+				Opts:        config.SkaffoldOptions{Namespace: test.namespace, KubeConfig: test.kubeconfig},
 				KubeContext: test.kubecontext,
 			})
 			err := cli.Run(context.Background(), nil, nil, "exec", "arg1", "arg2")
@@ -75,7 +83,7 @@ func TestCLI(t *testing.T) {
 			))
 
 			cli := NewFromRunContext(&runcontext.RunContext{
-				Opts:        config.SkaffoldOptions{Namespace: test.namespace},
+				Opts:        config.SkaffoldOptions{Namespace: test.namespace, KubeConfig: test.kubeconfig},
 				KubeContext: test.kubecontext,
 			})
 			out, err := cli.RunOut(context.Background(), "exec", "arg1", "arg2")
@@ -29,8 +29,9 @@ import (
 
 // for tests
 var (
-	Client        = getClientset
-	DynamicClient = getDynamicClient
+	Client           = getClientset
+	ClientForContext = getClientsetForContext
+	DynamicClient    = getDynamicClient
 )
 
 func getClientset() (kubernetes.Interface, error) {
@@ -41,6 +42,14 @@ func getClientset() (kubernetes.Interface, error) {
 	return kubernetes.NewForConfig(config)
 }
 
+func getClientsetForContext(kubeContext string) (kubernetes.Interface, error) {
+	config, err := context.GetRestClientConfigForContext(kubeContext)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting client config for kubernetes client")
+	}
+	return kubernetes.NewForConfig(config)
+}
+
 func getDynamicClient() (dynamic.Interface, error) {
 	config, err := context.GetRestClientConfig()
 	if err != nil {
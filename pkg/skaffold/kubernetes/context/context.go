@@ -35,6 +35,7 @@ var (
 var (
 	kubeConfigOnce sync.Once
 	kubeConfig     clientcmd.ClientConfig
+	kubeConfigFile string
 	kubeContext    string
 )
 
@@ -43,6 +44,12 @@ func resetConfig() {
 	kubeConfigOnce = sync.Once{}
 }
 
+// UseKubeConfig sets the path to the kubeconfig file to read instead of
+// the default one. It has to be called before the kubeconfig is first loaded.
+func UseKubeConfig(path string) {
+	kubeConfigFile = path
+}
+
 // UseKubeContext sets an override for the current context in the k8s config.
 func UseKubeContext(overrideKubeContext string) {
 	kubeContext = overrideKubeContext
@@ -57,6 +64,12 @@ func GetRestClientConfig() (*restclient.Config, error) {
 	return getRestClientConfig(kubeContext)
 }
 
+// GetRestClientConfigForContext returns a REST client config for API calls against
+// the Kubernetes API of the given kube-context.
+func GetRestClientConfigForContext(kctx string) (*restclient.Config, error) {
+	return getRestClientConfig(kctx)
+}
+
 func getRestClientConfig(kctx string) (*restclient.Config, error) {
 	logrus.Debugf("getting client config for kubeContext: `%s`", kctx)
 	rawConfig, err := getRawKubeConfig()
@@ -90,6 +103,7 @@ func getCurrentConfig() (clientcmdapi.Config, error) {
 func getRawKubeConfig() (clientcmdapi.Config, error) {
 	kubeConfigOnce.Do(func() {
 		loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+		loadingRules.ExplicitPath = kubeConfigFile
 		kubeConfig = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{
 			CurrentContext: kubeContext,
 		})
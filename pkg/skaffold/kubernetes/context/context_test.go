@@ -102,6 +102,16 @@ func TestCurrentContext(t *testing.T) {
 
 		t.CheckError(true, err)
 	})
+
+	testutil.Run(t, "explicit kubeconfig", func(t *testutil.T) {
+		resetKubeConfig(t, "invalid")
+
+		kubeConfigFile = t.TempFile("config", []byte(validKubeConfig))
+		config, err := CurrentConfig()
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(clusterFooContext, config.CurrentContext)
+	})
 }
 
 func TestGetRestClientConfig(t *testing.T) {
@@ -182,6 +192,7 @@ func TestGetRestClientConfig(t *testing.T) {
 func resetKubeConfig(t *testutil.T, content string) {
 	kubeConfig := t.TempFile("config", []byte(content))
 	kubeContext = ""
+	kubeConfigFile = ""
 	t.SetEnvs(map[string]string{"KUBECONFIG": kubeConfig})
 	resetConfig()
 }
@@ -31,9 +31,13 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 )
 
+// For testing
+var applicationLog = event.ApplicationLog
+
 // LogAggregator aggregates the logs for all the deployed pods.
 type LogAggregator struct {
 	output      io.Writer
@@ -44,6 +48,7 @@ type LogAggregator struct {
 
 	muted             int32
 	sinceTime         time.Time
+	emitEvents        bool
 	cancel            context.CancelFunc
 	trackedContainers trackedContainers
 	outputLock        sync.Mutex
@@ -67,6 +72,12 @@ func (a *LogAggregator) SetSince(t time.Time) {
 	a.sinceTime = t
 }
 
+// SetEmitEvents makes the logger send each log line as an event,
+// in addition to printing it.
+func (a *LogAggregator) SetEmitEvents(emit bool) {
+	a.emitEvents = emit
+}
+
 // Start starts a logger that listens to pods and tail their logs
 // if they are matched by the `podSelector`.
 func (a *LogAggregator) Start(ctx context.Context) error {
@@ -159,7 +170,7 @@ func (a *LogAggregator) streamContainerLogs(ctx context.Context, pod *v1.Pod, co
 
 	headerColor := a.colorPicker.Pick(pod)
 	prefix := prefix(pod, container)
-	if err := a.streamRequest(ctx, headerColor, prefix, tr); err != nil {
+	if err := a.streamRequest(ctx, headerColor, prefix, pod.Name, container.Name, tr); err != nil {
 		logrus.Errorf("streaming request %s", err)
 	}
 }
@@ -182,7 +193,7 @@ func prefix(pod *v1.Pod, container v1.ContainerStatus) string {
 	return fmt.Sprintf("[%s]", container.Name)
 }
 
-func (a *LogAggregator) streamRequest(ctx context.Context, headerColor color.Color, prefix string, rc io.Reader) error {
+func (a *LogAggregator) streamRequest(ctx context.Context, headerColor color.Color, prefix, podName, containerName string, rc io.Reader) error {
 	r := bufio.NewReader(rc)
 	for {
 		select {
@@ -204,6 +215,9 @@ func (a *LogAggregator) streamRequest(ctx context.Context, headerColor color.Col
 			}
 
 			a.printLogLine(headerColor, prefix, line)
+			if a.emitEvents {
+				applicationLog(podName, containerName, line)
+			}
 		}
 	}
 }
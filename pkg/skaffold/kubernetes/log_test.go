@@ -18,6 +18,7 @@ package kubernetes
 
 import (
 	"bytes"
+	"context"
 	"strings"
 	"sync"
 	"testing"
@@ -119,3 +120,40 @@ func TestPrintLogLine(t *testing.T) {
 		}
 	})
 }
+
+func TestStreamRequest(t *testing.T) {
+	tests := []struct {
+		description    string
+		emitEvents     bool
+		expectedEvents []string
+	}{
+		{
+			description: "only print",
+		},
+		{
+			description:    "print and emit events",
+			emitEvents:     true,
+			expectedEvents: []string{"pod container LINE1\n", "pod container LINE2\n"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var events []string
+			t.Override(&applicationLog, func(podName, containerName, message string) {
+				events = append(events, podName+" "+containerName+" "+message)
+			})
+
+			var buf bytes.Buffer
+			logger := &LogAggregator{
+				output: &buf,
+			}
+			logger.SetEmitEvents(test.emitEvents)
+
+			err := logger.streamRequest(context.Background(), color.Default, "[pod container]", "pod", "container", strings.NewReader("LINE1\nLINE2\n"))
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual("[pod container] LINE1\n[pod container] LINE2\n[pod container] <Container was Terminated>\n", buf.String())
+			t.CheckDeepEqual(test.expectedEvents, events)
+		})
+	}
+}
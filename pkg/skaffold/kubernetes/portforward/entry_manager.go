@@ -38,7 +38,18 @@ var (
 			entry.resource.Namespace,
 			entry.portName,
 			string(entry.resource.Type),
-			entry.resource.Name)
+			entry.resource.Name,
+			entry.protocol)
+	}
+
+	portForwardTerminatedEvent = func(entry *portForwardEntry) {
+		event.PortForwardTerminated(
+			int32(entry.localPort),
+			int32(entry.resource.Port),
+			entry.podName,
+			entry.containerName,
+			entry.resource.Namespace,
+			entry.portName)
 	}
 )
 
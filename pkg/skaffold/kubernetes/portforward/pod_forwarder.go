@@ -83,8 +83,9 @@ func (p *WatchingPodForwarder) Start(ctx context.Context) error {
 				if !ok {
 					continue
 				}
-				// If the event's type is "DELETED", continue.
+				// If the event's type is "DELETED", stop forwarding its ports.
 				if evt.Type == watch.Deleted {
+					p.terminatePod(pod)
 					continue
 				}
 				// At this point, we know the event's type is "ADDED" or "MODIFIED".
@@ -106,18 +107,11 @@ func (p *WatchingPodForwarder) portForwardPod(ctx context.Context, pod *v1.Pod)
 	for _, c := range pod.Spec.Containers {
 		for _, port := range c.Ports {
 			// get current entry for this container
-			resource := latest.PortForwardResource{
-				Type:      constants.Pod,
-				Name:      pod.Name,
-				Namespace: pod.Namespace,
-				Port:      int(port.ContainerPort),
-				LocalPort: int(port.ContainerPort),
-			}
-
-			entry, err := p.podForwardingEntry(pod.ResourceVersion, c.Name, port.Name, ownerReference, resource)
+			entry, err := p.podForwardingEntry(pod.ResourceVersion, c.Name, port.Name, ownerReference, podPortResource(pod, port))
 			if err != nil {
 				return errors.Wrap(err, "getting pod forwarding entry")
 			}
+			entry.protocol = string(port.Protocol)
 			if entry.resource.Port != entry.localPort {
 				color.Yellow.Fprintf(p.output, "Forwarding container %s/%s to local port %d.\n", pod.Name, c.Name, entry.localPort)
 			}
@@ -134,6 +128,37 @@ func (p *WatchingPodForwarder) portForwardPod(ctx context.Context, pod *v1.Pod)
 	return nil
 }
 
+// terminatePod stops forwarding the ports of a pod that was deleted.
+// The ports are forwarded again once a replacement pod is running.
+func (p *WatchingPodForwarder) terminatePod(pod *v1.Pod) {
+	ownerReference := topLevelOwnerKey(pod, pod.Kind)
+	for _, c := range pod.Spec.Containers {
+		for _, port := range c.Ports {
+			key := newPortForwardEntry(0, podPortResource(pod, port), pod.Name, c.Name, port.Name, ownerReference, 0, true).key()
+
+			// The entry might already be used by a replacement pod.
+			entry, ok := p.forwardedResources.Load(key)
+			if !ok || entry.podName != pod.Name {
+				continue
+			}
+
+			p.Terminate(entry)
+			color.Yellow.Fprintf(p.output, "Stopped port forwarding %s/%s, waiting for a replacement pod.\n", pod.Name, c.Name)
+			portForwardTerminatedEvent(entry)
+		}
+	}
+}
+
+func podPortResource(pod *v1.Pod, port v1.ContainerPort) latest.PortForwardResource {
+	return latest.PortForwardResource{
+		Type:      constants.Pod,
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+		Port:      int(port.ContainerPort),
+		LocalPort: int(port.ContainerPort),
+	}
+}
+
 func (p *WatchingPodForwarder) podForwardingEntry(resourceVersion, containerName, portName, ownerReference string, resource latest.PortForwardResource) (*portForwardEntry, error) {
 	rv, err := strconv.Atoi(resourceVersion)
 	if err != nil {
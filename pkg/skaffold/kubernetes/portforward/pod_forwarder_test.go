@@ -93,6 +93,54 @@ func TestAutomaticPortForwardPod(t *testing.T) {
 				},
 			},
 		},
+		{
+			description:    "udp container port",
+			expectedPorts:  map[int]struct{}{5353: {}},
+			availablePorts: []int{5353},
+			expectedEntries: map[string]*portForwardEntry{
+				"owner-containername-namespace-dns-5353": {
+					resourceVersion: 1,
+					podName:         "podname",
+					containerName:   "containername",
+					resource: latest.PortForwardResource{
+						Type:      "pod",
+						Name:      "podname",
+						Namespace: "namespace",
+						Port:      5353,
+						LocalPort: 5353,
+					},
+					ownerReference:         "owner",
+					automaticPodForwarding: true,
+					portName:               "dns",
+					protocol:               "UDP",
+					localPort:              5353,
+					terminationLock:        &sync.Mutex{},
+				},
+			},
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "podname",
+						ResourceVersion: "1",
+						Namespace:       "namespace",
+					},
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{
+							{
+								Name: "containername",
+								Ports: []v1.ContainerPort{
+									{
+										ContainerPort: 5353,
+										Name:          "dns",
+										Protocol:      v1.ProtocolUDP,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			description:   "unavailable container port",
 			expectedPorts: map[int]struct{}{9000: {}},
@@ -535,3 +583,77 @@ func TestStartPodForwarder(t *testing.T) {
 		})
 	}
 }
+
+func TestTerminateDeletedPod(t *testing.T) {
+	pod := func(name, resourceVersion string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				ResourceVersion: resourceVersion,
+				Namespace:       "namespace",
+			},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{
+					Name:  "containername",
+					Ports: []v1.ContainerPort{{ContainerPort: 8080, Name: "portname"}},
+				}},
+			},
+		}
+	}
+
+	tests := []struct {
+		description        string
+		forwarded          []*v1.Pod
+		deleted            *v1.Pod
+		expectedTerminated []string
+		expectedPodName    string
+	}{
+		{
+			description:        "deleted pod",
+			forwarded:          []*v1.Pod{pod("pod1", "1")},
+			deleted:            pod("pod1", "1"),
+			expectedTerminated: []string{"pod1"},
+		},
+		{
+			description:     "pod already replaced",
+			forwarded:       []*v1.Pod{pod("pod1", "1"), pod("pod2", "2")},
+			deleted:         pod("pod1", "1"),
+			expectedPodName: "pod2",
+		},
+		{
+			description: "unknown pod",
+			deleted:     pod("pod1", "1"),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			event.InitializeState(latest.BuildConfig{})
+			t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort(map[int]struct{}{}, []int{8080}))
+			t.Override(&topLevelOwnerKey, func(_ metav1.Object, _ string) string { return "owner" })
+			var terminated []string
+			t.Override(&portForwardTerminatedEvent, func(entry *portForwardEntry) {
+				terminated = append(terminated, entry.podName)
+			})
+
+			entryManager := EntryManager{
+				output:             ioutil.Discard,
+				forwardedPorts:     newForwardedPorts(),
+				forwardedResources: newForwardedResources(),
+				EntryForwarder:     newTestForwarder(),
+			}
+			p := NewWatchingPodForwarder(entryManager, kubernetes.NewImageList(), nil)
+			for _, pod := range test.forwarded {
+				t.CheckNoError(p.portForwardPod(context.Background(), pod))
+			}
+
+			p.terminatePod(test.deleted)
+
+			t.CheckDeepEqual(test.expectedTerminated, terminated)
+			entry, found := p.forwardedResources.Load("owner-containername-namespace-portname-8080")
+			t.CheckDeepEqual(test.expectedPodName != "", found)
+			if found {
+				t.CheckDeepEqual(test.expectedPodName, entry.podName)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ type portForwardEntry struct {
 	podName                string
 	containerName          string
 	portName               string
+	protocol               string
 	ownerReference         string
 	localPort              int
 	automaticPodForwarding bool
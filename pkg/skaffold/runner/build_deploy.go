@@ -31,8 +31,11 @@ import (
 
 // BuildAndTest builds and tests a list of artifacts.
 func (r *SkaffoldRunner) BuildAndTest(ctx context.Context, out io.Writer, artifacts []*latest.Artifact) ([]build.Artifact, error) {
+	artifacts = selectArtifacts(r.runCtx.Opts, artifacts)
+
 	tags, err := r.imageTags(ctx, out, artifacts)
 	if err != nil {
+		r.notifyRunComplete(nil, err)
 		return nil, err
 	}
 
@@ -48,6 +51,12 @@ func (r *SkaffoldRunner) BuildAndTest(ctx context.Context, out io.Writer, artifa
 			return nil, errors.Wrap(err, "build failed")
 		}
 
+		if r.runCtx.Opts.PruneAfterDeploy && r.runCtx.Cfg.Build.LocalBuild != nil {
+			for _, b := range bRes {
+				r.builtImages = append(r.builtImages, b.Tag)
+			}
+		}
+
 		if !r.runCtx.Opts.SkipTests {
 			if err = r.tester.Test(ctx, out, bRes); err != nil {
 				return nil, errors.Wrap(err, "test failed")
@@ -57,6 +66,7 @@ func (r *SkaffoldRunner) BuildAndTest(ctx context.Context, out io.Writer, artifa
 		return bRes, nil
 	})
 	if err != nil {
+		r.notifyRunComplete(nil, err)
 		return nil, err
 	}
 
@@ -84,23 +94,32 @@ func (r *SkaffoldRunner) BuildAndTest(ctx context.Context, out io.Writer, artifa
 }
 
 // DeployAndLog deploys a list of already built artifacts and optionally show the logs.
+// The logs of the deployed images are streamed, both to the output and as events,
+// once the deployments have stabilized and until the context is cancelled.
 func (r *SkaffoldRunner) DeployAndLog(ctx context.Context, out io.Writer, artifacts []build.Artifact) error {
 	if !r.runCtx.Opts.Tail {
-		return r.Deploy(ctx, out, artifacts)
+		err := r.Deploy(ctx, out, artifacts)
+		r.notifyRunComplete(artifacts, err)
+		return err
 	}
 
 	var imageNames []string
 	for _, artifact := range artifacts {
 		imageNames = append(imageNames, artifact.ImageName)
+		// Artifacts that were built by a previous command are only known here.
+		r.imageList.Add(artifact.Tag)
 	}
 
 	logger := r.newLoggerForImages(out, imageNames)
+	logger.SetEmitEvents(true)
 	defer logger.Stop()
 
 	// Logs should be retrieve up to just before the deploy
 	logger.SetSince(time.Now())
 
-	if err := r.Deploy(ctx, out, artifacts); err != nil {
+	err := r.Deploy(ctx, out, artifacts)
+	r.notifyRunComplete(artifacts, err)
+	if err != nil {
 		return err
 	}
 
@@ -17,6 +17,9 @@ limitations under the License.
 package runner
 
 import (
+	"sort"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/sync"
 )
@@ -28,6 +31,49 @@ type changeSet struct {
 	resyncTracker  map[string]*sync.Item
 	needsRedeploy  bool
 	needsReload    bool
+
+	// changedPaths and changedArtifacts accumulate the file changes
+	// until they are reported.
+	changedPaths     map[string]bool
+	changedArtifacts map[string]bool
+}
+
+// AddChangedFiles records the files that have changed, and the artifact
+// they belong to, if any.
+func (c *changeSet) AddChangedFiles(e filemon.Events, artifact string) {
+	if c.changedPaths == nil {
+		c.changedPaths = map[string]bool{}
+		c.changedArtifacts = map[string]bool{}
+	}
+
+	for _, paths := range [][]string{e.Added, e.Modified, e.Deleted} {
+		for _, path := range paths {
+			c.changedPaths[path] = true
+		}
+	}
+	if artifact != "" {
+		c.changedArtifacts[artifact] = true
+	}
+}
+
+// changedFiles returns the sorted paths and artifacts that have changed
+// since the last reset.
+func (c *changeSet) changedFiles() ([]string, []string) {
+	return sortedKeys(c.changedPaths), sortedKeys(c.changedArtifacts)
+}
+
+func (c *changeSet) resetChangedFiles() {
+	c.changedPaths = nil
+	c.changedArtifacts = nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 func (c *changeSet) AddRebuild(a *latest.Artifact) {
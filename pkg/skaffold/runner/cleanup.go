@@ -19,8 +19,35 @@ package runner
 import (
 	"context"
 	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 )
 
 func (r *SkaffoldRunner) Cleanup(ctx context.Context, out io.Writer) error {
-	return r.deployer.Cleanup(ctx, out)
+	if len(r.kubeContextDeployers) == 0 {
+		return r.cleanup(ctx, out, r.runCtx, r.deployer)
+	}
+
+	for _, d := range r.kubeContextDeployers {
+		if err := r.cleanup(ctx, out, d.runCtx, d.deployer); err != nil {
+			return errors.Wrapf(err, "cleaning up kube-context %s", d.runCtx.KubeContext)
+		}
+	}
+	return nil
+}
+
+func (r *SkaffoldRunner) cleanup(ctx context.Context, out io.Writer, runCtx *runcontext.RunContext, deployer deploy.Deployer) error {
+	if err := deployer.Cleanup(ctx, out); err != nil {
+		return err
+	}
+
+	if runCtx.Opts.CreateNamespaces {
+		if err := r.deleteCreatedNamespaces(ctx, out, runCtx, deployer); err != nil {
+			return errors.Wrap(err, "deleting namespaces")
+		}
+	}
+	return nil
 }
@@ -17,48 +17,205 @@ limitations under the License.
 package runner
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"io"
+	"io/ioutil"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 )
 
 func (r *SkaffoldRunner) Deploy(ctx context.Context, out io.Writer, artifacts []build.Artifact) error {
+	artifacts = selectBuilds(r.runCtx.Opts, artifacts)
+
 	if r.runCtx.Opts.RenderOnly {
 		return r.Render(ctx, out, artifacts, "")
 	}
 
-	if config.IsKindCluster(r.runCtx.KubeContext) {
+	manifestsHash := r.renderedManifestsHash(ctx, artifacts)
+	if r.hasDeployed && manifestsHash != "" && manifestsHash == r.deployedManifestsHash {
+		color.Default.Fprintln(out, "Manifests are unchanged, skipping deploy")
+		event.DeploySkipped()
+		return nil
+	}
+
+	// Nothing is deployed in validate-only mode, so there's nothing to prepare or follow up on.
+	runHooks := !r.runCtx.Opts.ValidateOnly
+
+	if runHooks {
+		if err := runDeployHooks(ctx, out, preDeployPhase, r.runCtx.Cfg.Deploy.PreDeploy, artifacts); err != nil {
+			return err
+		}
+	}
+
+	if err := r.deployToKubeContexts(ctx, out, artifacts, manifestsHash); err != nil {
+		return err
+	}
+
+	if !runHooks {
+		return nil
+	}
+
+	if err := runDeployHooks(ctx, out, postDeployPhase, r.runCtx.Cfg.Deploy.PostDeploy, artifacts); err != nil {
+		return err
+	}
+
+	if r.runCtx.Opts.PruneAfterDeploy {
+		r.pruneBuiltImages(ctx, out, artifacts)
+	}
+	return nil
+}
+
+// deployToKubeContexts deploys the artifacts to the current kube-context
+// or, if several were given, to each of them.
+func (r *SkaffoldRunner) deployToKubeContexts(ctx context.Context, out io.Writer, artifacts []build.Artifact, manifestsHash string) error {
+	r.deployedResources = nil
+
+	if len(r.kubeContextDeployers) == 0 {
+		return r.deployTo(ctx, out, artifacts, manifestsHash, r.runCtx, r.deployer)
+	}
+
+	// Deploy to each kube-context in sequence.
+	for _, d := range r.kubeContextDeployers {
+		kubeContext := d.runCtx.KubeContext
+		color.Default.Fprintln(out, "Deploying to kube-context", kubeContext)
+		event.DeployToKubeContextInProgress(kubeContext)
+
+		if err := r.deployTo(ctx, out, artifacts, manifestsHash, d.runCtx, d.deployer); err != nil {
+			event.DeployToKubeContextFailed(kubeContext, err)
+			return errors.Wrapf(err, "deploying to kube-context %s", kubeContext)
+		}
+		event.DeployToKubeContextComplete(kubeContext)
+	}
+	return nil
+}
+
+// deployTo deploys the artifacts with the given deployer, to the kube-context of the given run context.
+func (r *SkaffoldRunner) deployTo(ctx context.Context, out io.Writer, artifacts []build.Artifact, manifestsHash string, runCtx *runcontext.RunContext, deployer deploy.Deployer) error {
+	if runCtx.Opts.ValidateOnly {
+		// Nothing is deployed: there are no images to load and no status to check.
+		return r.deployWithRetries(ctx, out, runCtx, deployer, artifacts).GetError()
+	}
+
+	if config.IsKindCluster(runCtx.KubeContext) {
 		// With `kind`, docker images have to be loaded with the `kind` CLI.
-		if err := r.loadImagesInKindNodes(ctx, out, artifacts); err != nil {
+		if err := r.loadImagesInKindNodes(ctx, out, runCtx, artifacts); err != nil {
 			return errors.Wrapf(err, "loading images into kind nodes")
 		}
 	}
 
-	deployResult := r.deployer.Deploy(ctx, out, artifacts, r.labellers)
+	if config.IsK3dCluster(runCtx.KubeContext) {
+		// With `k3d`, docker images have to be imported with the `k3d` CLI.
+		if err := r.loadImagesInK3dNodes(ctx, out, runCtx, artifacts); err != nil {
+			return errors.Wrapf(err, "loading images into k3d nodes")
+		}
+	}
+
+	if runCtx.Opts.CreateNamespaces {
+		if err := r.createNamespaces(ctx, out, runCtx, deployer, artifacts); err != nil {
+			return errors.Wrap(err, "creating namespaces")
+		}
+	}
+
+	deployResult := r.deployWithRetries(ctx, out, runCtx, deployer, artifacts)
 	r.hasDeployed = true
 	if err := deployResult.GetError(); err != nil {
+		r.deployedManifestsHash = ""
 		return err
 	}
+	r.deployedManifestsHash = manifestsHash
+	r.deployedResources = append(r.deployedResources, deployResult.Resources()...)
 	r.runCtx.UpdateNamespaces(deployResult.Namespaces())
-	return r.performStatusCheck(ctx, out)
+	if runCtx != r.runCtx {
+		runCtx.UpdateNamespaces(deployResult.Namespaces())
+	}
+
+	if err := r.performStatusCheck(ctx, out, runCtx, deployResult.Resources()); err != nil {
+		if runCtx.Opts.RollbackOnFailure {
+			r.rollback(ctx, out, deployer)
+		}
+		return err
+	}
+	return nil
 }
 
-func (r *SkaffoldRunner) performStatusCheck(ctx context.Context, out io.Writer) error {
+// rollback rolls back the Deployments that were just updated.
+func (r *SkaffoldRunner) rollback(ctx context.Context, out io.Writer, deployer deploy.Deployer) {
+	rollbacker, ok := deployer.(deploy.Rollbacker)
+	if !ok {
+		logrus.Warnln("Deployer doesn't support rollbacks")
+		return
+	}
+
+	color.Default.Fprintln(out, "Rolling back deployments")
+	event.DeployRollbackInProgress()
+
+	rolledBack, err := rollbacker.Rollback(ctx, out, r.defaultLabeller)
+	// The manifests that were deployed are not running anymore.
+	r.deployedManifestsHash = ""
+	if err != nil {
+		color.Red.Fprintln(out, "Rollback failed:", err)
+		event.DeployRollbackFailed(rolledBack, err)
+		return
+	}
+
+	for _, resource := range rolledBack {
+		color.Default.Fprintln(out, " -", resource, "rolled back")
+	}
+	event.DeployRollbackComplete(rolledBack)
+}
+
+// renderedManifestsHash computes a hash of the manifests that would be deployed.
+// An empty hash means that the manifests couldn't be rendered and shouldn't be
+// compared with the previous deployment.
+func (r *SkaffoldRunner) renderedManifestsHash(ctx context.Context, artifacts []build.Artifact) string {
+	var manifests bytes.Buffer
+	if err := r.deployer.Render(ctx, &manifests, artifacts, ""); err != nil {
+		logrus.Debugf("unable to render manifests: %s", err)
+		return ""
+	}
+	if manifests.Len() == 0 {
+		return ""
+	}
+
+	hash := sha256.Sum256(manifests.Bytes())
+	return hex.EncodeToString(hash[:])
+}
+
+func (r *SkaffoldRunner) performStatusCheck(ctx context.Context, out io.Writer, runCtx *runcontext.RunContext, applied []deploy.AppliedResource) error {
 	// Check if we need to perform deploy status
-	if r.runCtx.Opts.StatusCheck {
+	if runCtx.Opts.StatusCheck {
 		start := time.Now()
-		color.Default.Fprintln(out, "Waiting for deployments to stabilize")
-		err := statusCheck(ctx, r.defaultLabeller, r.runCtx, out)
+		textOut := out
+		if runCtx.Opts.JSONOutput {
+			// The outcome is printed as JSON by the status check.
+			textOut = ioutil.Discard
+		}
+		color.Default.Fprintln(textOut, "Waiting for deployments to stabilize")
+		event.StatusCheckEventStarted()
+		err := statusCheck(ctx, r.defaultLabeller, applied, runCtx, out)
+		event.DeployPhaseCompleted(event.StatusCheckPhase, time.Since(start))
 		if err != nil {
+			if ctx.Err() == context.Canceled {
+				event.StatusCheckEventCancelled()
+			} else {
+				event.StatusCheckEventFailed(err)
+			}
 			return err
 		}
-		color.Default.Fprintln(out, "Deployments stabilized in", time.Since(start))
+		event.StatusCheckEventSucceeded()
+		color.Default.Fprintln(textOut, "Deployments stabilized in", time.Since(start))
 	}
 	return nil
 }
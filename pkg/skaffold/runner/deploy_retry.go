@@ -0,0 +1,99 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+)
+
+// deployRetryBackoff is the delay between two attempts at deploying.
+// It can be overridden for testing.
+var deployRetryBackoff = wait.Backoff{
+	Duration: time.Second,
+	Factor:   2,
+	Jitter:   0.1,
+	Steps:    5,
+}
+
+// transientDeployErrors are the messages, printed by the deployers or found in their errors,
+// that denote a temporary failure of the cluster rather than a problem with the manifests.
+var transientDeployErrors = []string{
+	"connection refused",
+	"connection reset by peer",
+	"i/o timeout",
+	"TLS handshake timeout",
+	"etcdserver: leader changed",
+	"etcdserver: request timed out",
+	"the server is currently unable to handle the request",
+	"Client.Timeout exceeded",
+}
+
+// deployWithRetries deploys the artifacts and retries, with an exponential backoff,
+// the attempts that fail with a transient error. Other failures are returned immediately.
+func (r *SkaffoldRunner) deployWithRetries(ctx context.Context, out io.Writer, runCtx *runcontext.RunContext, deployer deploy.Deployer, artifacts []build.Artifact) *deploy.Result {
+	backoff := deployRetryBackoff
+
+	for attempt := 1; ; attempt++ {
+		var attemptOut bytes.Buffer
+		deployCtx, cancel := withGracefulShutdown(ctx, out, time.Duration(runCtx.Opts.GracefulShutdownTimeout)*time.Second)
+		result := deployer.Deploy(deployCtx, io.MultiWriter(out, &attemptOut), artifacts, r.labellers)
+		cancel()
+
+		err := result.GetError()
+		if kubectl.IsFieldConflict(err) && !runCtx.Opts.ForceConflicts {
+			return deploy.NewDeployErrorResult(errors.Wrap(err, "use --force-conflicts to take the ownership of the conflicting fields"))
+		}
+		if err == nil || attempt > runCtx.Opts.DeployRetries || !isTransientDeployError(err, attemptOut.String()) {
+			return result
+		}
+
+		delay := backoff.Step()
+		color.Yellow.Fprintf(out, "Deploy failed with a transient error, retrying in %v...\n", delay.Round(time.Millisecond))
+		event.DeployRetry(attempt, err)
+
+		select {
+		case <-ctx.Done():
+			return result
+		case <-time.After(delay):
+		}
+	}
+}
+
+// isTransientDeployError tells whether a deploy failed because of a temporary
+// failure of the cluster, based on the error and on the deployer's output.
+func isTransientDeployError(err error, output string) bool {
+	for _, msg := range transientDeployErrors {
+		if strings.Contains(err.Error(), msg) || strings.Contains(output, msg) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestDeployWithRetries(t *testing.T) {
+	transient := errors.New("kubectl apply: dial tcp 10.0.0.1:443: connect: connection refused")
+	invalid := errors.New("kubectl apply: error validating data: unknown field \"replica\"")
+
+	tests := []struct {
+		description       string
+		retries           int
+		deployErrors      []error
+		shouldErr         bool
+		expectedRemaining int
+	}{
+		{
+			description:  "retry transient error",
+			retries:      2,
+			deployErrors: []error{transient, nil},
+		},
+		{
+			description:  "give up after the last retry",
+			retries:      1,
+			deployErrors: []error{transient, transient},
+			shouldErr:    true,
+		},
+		{
+			description:       "no retries by default",
+			deployErrors:      []error{transient, nil},
+			shouldErr:         true,
+			expectedRemaining: 1,
+		},
+		{
+			description:       "don't retry other errors",
+			retries:           3,
+			deployErrors:      []error{invalid, nil},
+			shouldErr:         true,
+			expectedRemaining: 1,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+			t.Override(&deployRetryBackoff, wait.Backoff{Duration: time.Millisecond})
+
+			testBench := &TestBench{deployErrors: test.deployErrors}
+			runner := createRunner(t, testBench, nil)
+			runner.runCtx.Opts.DeployRetries = test.retries
+
+			err := runner.Deploy(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "img1", Tag: "img1:tag1"}})
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedRemaining, len(testBench.deployErrors))
+		})
+	}
+}
+
+func TestIsTransientDeployError(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+		output      string
+		expected    bool
+	}{
+		{
+			description: "transient error",
+			err:         errors.New("Get https://10.0.0.1/api: net/http: TLS handshake timeout"),
+			expected:    true,
+		},
+		{
+			description: "transient error in the output",
+			err:         errors.New("kubectl apply: exit status 1"),
+			output:      "Error from server: etcdserver: leader changed\n",
+			expected:    true,
+		},
+		{
+			description: "validation error",
+			err:         errors.New("kubectl apply: exit status 1"),
+			output:      "error: error validating \"STDIN\": error validating data: unknown field \"replica\"\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, isTransientDeployError(test.err, test.output))
+		})
+	}
+}
@@ -22,14 +22,21 @@ import (
 	"errors"
 	"io"
 	"io/ioutil"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -60,7 +67,7 @@ func TestDeploy(t *testing.T) {
 		},
 	}
 
-	dummyStatusCheck := func(context.Context, *deploy.DefaultLabeller, *runcontext.RunContext, io.Writer) error {
+	dummyStatusCheck := func(context.Context, *deploy.DefaultLabeller, []deploy.AppliedResource, *runcontext.RunContext, io.Writer) error {
 		return nil
 	}
 	for _, test := range tests {
@@ -110,7 +117,7 @@ func TestDeployNamespace(t *testing.T) {
 		},
 	}
 
-	dummyStatusCheck := func(context.Context, *deploy.DefaultLabeller, *runcontext.RunContext, io.Writer) error {
+	dummyStatusCheck := func(context.Context, *deploy.DefaultLabeller, []deploy.AppliedResource, *runcontext.RunContext, io.Writer) error {
 		return nil
 	}
 	for _, test := range tests {
@@ -131,3 +138,243 @@ func TestDeployNamespace(t *testing.T) {
 		})
 	}
 }
+
+func TestDeploySkipsUnchangedManifests(t *testing.T) {
+	tests := []struct {
+		description      string
+		testBench        *TestBench
+		secondDeployTags []build.Artifact
+		expectedDeployed []string
+		expectedOutput   string
+	}{
+		{
+			description:      "skip deploy when manifests are unchanged",
+			testBench:        NewTestBench().WithRenderedTags(),
+			secondDeployTags: []build.Artifact{{ImageName: "img1", Tag: "img1:tag1"}},
+			expectedOutput:   "Manifests are unchanged, skipping deploy",
+		},
+		{
+			description:      "deploy when manifests changed",
+			testBench:        NewTestBench().WithRenderedTags(),
+			secondDeployTags: []build.Artifact{{ImageName: "img1", Tag: "img1:tag2"}},
+			expectedDeployed: []string{"img1:tag2"},
+		},
+		{
+			description:      "deploy when manifests can't be compared",
+			testBench:        NewTestBench(),
+			secondDeployTags: []build.Artifact{{ImageName: "img1", Tag: "img1:tag1"}},
+			expectedDeployed: []string{"img1:tag1"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+
+			runner := createRunner(t, test.testBench, nil)
+			err := runner.Deploy(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "img1", Tag: "img1:tag1"}})
+			t.CheckNoError(err)
+
+			test.testBench.currentActions = Actions{}
+			out := new(bytes.Buffer)
+			err = runner.Deploy(context.Background(), out, test.secondDeployTags)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expectedDeployed, test.testBench.currentActions.Deployed)
+			if test.expectedOutput != "" {
+				t.CheckContains(test.expectedOutput, out.String())
+			}
+		})
+	}
+}
+
+type rollbackBench struct {
+	*TestBench
+	rolledBack bool
+}
+
+func (r *rollbackBench) Rollback(context.Context, io.Writer, *deploy.DefaultLabeller) ([]string, error) {
+	r.rolledBack = true
+	return []string{"test:deployment/dep"}, nil
+}
+
+func TestDeployRollbackOnFailure(t *testing.T) {
+	tests := []struct {
+		description        string
+		rollbackOnFailure  bool
+		statusCheckErr     error
+		expectedRolledBack bool
+	}{
+		{
+			description:        "rollback when status check fails",
+			rollbackOnFailure:  true,
+			statusCheckErr:     errors.New("1/1 deployment(s) failed"),
+			expectedRolledBack: true,
+		},
+		{
+			description:       "no rollback when status check succeeds",
+			rollbackOnFailure: true,
+		},
+		{
+			description:    "rollback is off by default",
+			statusCheckErr: errors.New("1/1 deployment(s) failed"),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+			t.Override(&statusCheck, func(context.Context, *deploy.DefaultLabeller, []deploy.AppliedResource, *runcontext.RunContext, io.Writer) error {
+				return test.statusCheckErr
+			})
+
+			testBench := &TestBench{}
+			runner := createRunner(t, testBench, nil)
+			deployer := &rollbackBench{TestBench: testBench}
+			runner.deployer = deployer
+			runner.runCtx.Opts.StatusCheck = true
+			runner.runCtx.Opts.RollbackOnFailure = test.rollbackOnFailure
+
+			err := runner.Deploy(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "img1", Tag: "img1:tag1"}})
+
+			t.CheckError(test.statusCheckErr != nil, err)
+			t.CheckDeepEqual(test.expectedRolledBack, deployer.rolledBack)
+		})
+	}
+}
+
+func TestDeployHooks(t *testing.T) {
+	tests := []struct {
+		description      string
+		commands         util.Command
+		shouldErr        bool
+		expectedDeployed []string
+	}{
+		{
+			description:      "run hooks around the deploy",
+			commands:         testutil.CmdRun("sh -c kubectl apply -f crd.yaml").AndRun("sh -c ./migrate.sh"),
+			expectedDeployed: []string{"img1:tag1"},
+		},
+		{
+			description: "failing pre-deploy hook aborts the deploy",
+			commands:    testutil.CmdRunErr("sh -c kubectl apply -f crd.yaml", errors.New("BUG")),
+			shouldErr:   true,
+		},
+		{
+			description:      "failing post-deploy hook fails the deploy",
+			commands:         testutil.CmdRun("sh -c kubectl apply -f crd.yaml").AndRunErr("sh -c ./migrate.sh", errors.New("BUG")),
+			shouldErr:        true,
+			expectedDeployed: []string{"img1:tag1"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			if runtime.GOOS == "windows" {
+				t.Skip("hooks are run with cmd.exe on windows")
+			}
+			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			testBench := &TestBench{}
+			runner := createRunner(t, testBench, nil)
+			runner.runCtx.Cfg.Deploy.PreDeploy = []string{"kubectl apply -f crd.yaml"}
+			runner.runCtx.Cfg.Deploy.PostDeploy = []string{"./migrate.sh"}
+
+			err := runner.Deploy(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "img1", Tag: "img1:tag1"}})
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedDeployed, testBench.currentActions.Deployed)
+		})
+	}
+}
+
+func TestDeployStatusCheckCancelled(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+		t.Override(&statusCheck, func(ctx context.Context, _ *deploy.DefaultLabeller, _ []deploy.AppliedResource, _ *runcontext.RunContext, _ io.Writer) error {
+			return ctx.Err()
+		})
+		event.InitializeState(latest.BuildConfig{})
+
+		runner := createRunner(t, &TestBench{}, nil)
+		runner.runCtx.Opts.StatusCheck = true
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := runner.Deploy(ctx, ioutil.Discard, []build.Artifact{{ImageName: "img1", Tag: "img1:tag1"}})
+
+		t.CheckError(true, err)
+		waitForState(t, func(state *proto.State) bool { return state.StatusCheckState.Status == event.Cancelled })
+	})
+}
+
+func waitForState(t *testutil.T, condition func(*proto.State) bool) {
+	err := wait.PollImmediate(10*time.Millisecond, 5*time.Second, func() (bool, error) {
+		state, err := event.GetState()
+		return err == nil && condition(state), nil
+	})
+	t.CheckNoError(err)
+}
+
+type kubeContextBench struct {
+	*TestBench
+	kubeContext string
+	deployed    *[]string
+}
+
+func (b *kubeContextBench) Deploy(ctx context.Context, out io.Writer, artifacts []build.Artifact, labellers []deploy.Labeller) *deploy.Result {
+	*b.deployed = append(*b.deployed, b.kubeContext)
+	return b.TestBench.Deploy(ctx, out, artifacts, labellers)
+}
+
+func TestDeployToKubeContexts(t *testing.T) {
+	tests := []struct {
+		description           string
+		deployErrors          map[string]error
+		shouldErr             bool
+		expectedDeployed      []string
+		expectedStatusChecked []string
+	}{
+		{
+			description:           "deploy to each kube-context",
+			expectedDeployed:      []string{"cluster1", "cluster2"},
+			expectedStatusChecked: []string{"cluster1", "cluster2"},
+		},
+		{
+			description:      "stop at first failure",
+			deployErrors:     map[string]error{"cluster1": errors.New("deploy error")},
+			shouldErr:        true,
+			expectedDeployed: []string{"cluster1"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+			var statusChecked []string
+			t.Override(&statusCheck, func(_ context.Context, _ *deploy.DefaultLabeller, _ []deploy.AppliedResource, runCtx *runcontext.RunContext, _ io.Writer) error {
+				statusChecked = append(statusChecked, runCtx.KubeContext)
+				return nil
+			})
+
+			runner := createRunner(t, &TestBench{}, nil)
+			runner.runCtx.Opts.StatusCheck = true
+			var deployed []string
+			for _, kubeContext := range []string{"cluster1", "cluster2"} {
+				testBench := &TestBench{}
+				if err, found := test.deployErrors[kubeContext]; found {
+					testBench.deployErrors = []error{err}
+				}
+				runCtx := *runner.runCtx
+				runCtx.KubeContext = kubeContext
+				runner.kubeContextDeployers = append(runner.kubeContextDeployers, kubeContextDeployer{
+					runCtx:   &runCtx,
+					deployer: &kubeContextBench{TestBench: testBench, kubeContext: kubeContext, deployed: &deployed},
+				})
+			}
+
+			err := runner.Deploy(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "img1", Tag: "img1:tag1"}})
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedDeployed, deployed)
+			t.CheckDeepEqual(test.expectedStatusChecked, statusChecked)
+		})
+	}
+}
@@ -36,6 +36,7 @@ var ErrorConfigurationChanged = errors.New("configuration changed")
 
 func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer) error {
 	if r.changeSet.needsReload {
+		r.reportChangedFiles()
 		return ErrorConfigurationChanged
 	}
 
@@ -47,6 +48,8 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer) error {
 		return nil
 	}
 
+	r.reportChangedFiles()
+
 	r.logger.Mute()
 	// if any action is going to be performed, reset the monitor's changed component tracker for debouncing
 	defer r.monitor.Reset()
@@ -82,7 +85,7 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer) error {
 	}
 
 	if needsDeploy {
-		event.ResetStateOnDeploy()
+		event.ResetStateOnRedeploy()
 		defer func() {
 			r.changeSet.resetDeploy()
 			r.intents.resetDeploy()
@@ -102,6 +105,18 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer) error {
 	return nil
 }
 
+// reportChangedFiles sends a single event for all the file changes
+// that led to the upcoming sync, build or deploy.
+func (r *SkaffoldRunner) reportChangedFiles() {
+	paths, artifacts := r.changeSet.changedFiles()
+	if len(paths) == 0 {
+		return
+	}
+
+	event.FileChanged(paths, artifacts)
+	r.changeSet.resetChangedFiles()
+}
+
 // Dev watches for changes and runs the skaffold build and deploy
 // config until interrupted by the user.
 func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*latest.Artifact) error {
@@ -131,6 +146,7 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 			if err := r.monitor.Register(
 				func() ([]string, error) { return r.builder.DependenciesForArtifact(ctx, artifact) },
 				func(e filemon.Events) {
+					r.changeSet.AddChangedFiles(e, artifact.ImageName)
 					syncMap := func() (map[string][]string, error) { return r.builder.SyncMap(ctx, artifact) }
 					s, err := sync.NewItem(artifact, e, r.builds, r.runCtx.InsecureRegistries, syncMap)
 					switch {
@@ -151,7 +167,10 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 	// Watch test configuration
 	if err := r.monitor.Register(
 		r.tester.TestDependencies,
-		func(filemon.Events) { r.changeSet.needsRedeploy = true },
+		func(e filemon.Events) {
+			r.changeSet.AddChangedFiles(e, "")
+			r.changeSet.needsRedeploy = true
+		},
 	); err != nil {
 		return errors.Wrap(err, "watching test files")
 	}
@@ -159,7 +178,10 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 	// Watch deployment configuration
 	if err := r.monitor.Register(
 		r.deployer.Dependencies,
-		func(filemon.Events) { r.changeSet.needsRedeploy = true },
+		func(e filemon.Events) {
+			r.changeSet.AddChangedFiles(e, "")
+			r.changeSet.needsRedeploy = true
+		},
 	); err != nil {
 		return errors.Wrap(err, "watching files for deployer")
 	}
@@ -167,7 +189,10 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 	// Watch Skaffold configuration
 	if err := r.monitor.Register(
 		func() ([]string, error) { return []string{r.runCtx.Opts.ConfigurationFile}, nil },
-		func(filemon.Events) { r.changeSet.needsReload = true },
+		func(e filemon.Events) {
+			r.changeSet.AddChangedFiles(e, "")
+			r.changeSet.needsReload = true
+		},
 	); err != nil {
 		return errors.Wrapf(err, "watching skaffold configuration %s", r.runCtx.Opts.ConfigurationFile)
 	}
@@ -349,3 +349,23 @@ func TestDevSync(t *testing.T) {
 		})
 	}
 }
+
+func TestChangedFilesAreCoalesced(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		c := &changeSet{}
+
+		c.AddChangedFiles(filemon.Events{Modified: []string{"src/main.go"}}, "img1")
+		c.AddChangedFiles(filemon.Events{Added: []string{"src/util.go"}, Modified: []string{"src/main.go"}}, "img1")
+		c.AddChangedFiles(filemon.Events{Deleted: []string{"lib/old.go"}}, "img2")
+		c.AddChangedFiles(filemon.Events{Modified: []string{"k8s/deployment.yaml"}}, "")
+
+		paths, artifacts := c.changedFiles()
+		t.CheckDeepEqual([]string{"k8s/deployment.yaml", "lib/old.go", "src/main.go", "src/util.go"}, paths)
+		t.CheckDeepEqual([]string{"img1", "img2"}, artifacts)
+
+		c.resetChangedFiles()
+		paths, artifacts = c.changedFiles()
+		t.CheckDeepEqual(0, len(paths))
+		t.CheckDeepEqual(0, len(artifacts))
+	})
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	preDeployPhase  = "pre-deploy"
+	postDeployPhase = "post-deploy"
+)
+
+// runDeployHooks runs the given commands in sequence and stops at the first failure.
+func runDeployHooks(ctx context.Context, out io.Writer, phase string, commands []string, artifacts []build.Artifact) error {
+	if len(commands) == 0 {
+		return nil
+	}
+
+	env := hookEnv(artifacts)
+	for _, command := range commands {
+		color.Default.Fprintf(out, "Running %s hook: %s\n", phase, command)
+		event.DeployHookInProgress(phase, command)
+
+		cmd := hookCommand(ctx, command)
+		cmd.Env = env
+		cmd.Stdout = out
+		cmd.Stderr = out
+		if err := util.RunCmd(cmd); err != nil {
+			event.DeployHookFailed(phase, command, err)
+			return errors.Wrapf(err, "running %s hook %q", phase, command)
+		}
+
+		event.DeployHookComplete(phase, command)
+	}
+
+	return nil
+}
+
+func hookCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
+
+// hookEnv exposes the images that were built to the hooks:
+// IMAGES lists all of them, IMAGE_<NAME> gives the image of each artifact
+// and, if a single artifact was built, IMAGE gives its image.
+func hookEnv(artifacts []build.Artifact) []string {
+	var env []string
+	var images []string
+	for _, artifact := range artifacts {
+		images = append(images, artifact.Tag)
+		env = append(env, fmt.Sprintf("IMAGE_%s=%s", envVarName(artifact.ImageName), artifact.Tag))
+	}
+
+	env = append(env, fmt.Sprintf("%s=%s", constants.Images, strings.Join(images, " ")))
+	if len(artifacts) == 1 {
+		env = append(env, fmt.Sprintf("IMAGE=%s", artifacts[0].Tag))
+	}
+
+	return append(env, util.OSEnviron()...)
+}
+
+// envVarName turns an image name into a valid env variable name.
+// eg: gcr.io/project/app-name -> GCR_IO_PROJECT_APP_NAME
+func envVarName(imageName string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, imageName)
+}
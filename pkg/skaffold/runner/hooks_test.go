@@ -0,0 +1,70 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestHookEnv(t *testing.T) {
+	tests := []struct {
+		description string
+		artifacts   []build.Artifact
+		expected    []string
+	}{
+		{
+			description: "single artifact",
+			artifacts:   []build.Artifact{{ImageName: "gcr.io/project/app-name", Tag: "gcr.io/project/app-name:tag@sha256:abac"}},
+			expected: []string{
+				"IMAGE_GCR_IO_PROJECT_APP_NAME=gcr.io/project/app-name:tag@sha256:abac",
+				"IMAGES=gcr.io/project/app-name:tag@sha256:abac",
+				"IMAGE=gcr.io/project/app-name:tag@sha256:abac",
+				"KEY=VALUE",
+			},
+		},
+		{
+			description: "several artifacts",
+			artifacts: []build.Artifact{
+				{ImageName: "front", Tag: "front:v1"},
+				{ImageName: "back", Tag: "back:v2"},
+			},
+			expected: []string{
+				"IMAGE_FRONT=front:v1",
+				"IMAGE_BACK=back:v2",
+				"IMAGES=front:v1 back:v2",
+				"KEY=VALUE",
+			},
+		},
+		{
+			description: "no artifacts",
+			expected:    []string{"IMAGES=", "KEY=VALUE"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.OSEnviron, func() []string { return []string{"KEY=VALUE"} })
+
+			env := hookEnv(test.artifacts)
+
+			t.CheckDeepEqual(test.expected, env)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"io"
+	"os/exec"
+
+	"github.com/pkg/errors"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+)
+
+// loadImagesInK3dNodes loads a list of artifact images into every node of a k3d cluster.
+func (r *SkaffoldRunner) loadImagesInK3dNodes(ctx context.Context, out io.Writer, runCtx *runcontext.RunContext, artifacts []build.Artifact) error {
+	if _, err := lookPath("k3d"); err != nil {
+		return errors.Wrap(err, "k3d cluster detected but the `k3d` binary could not be found in PATH")
+	}
+
+	clusterName := config.K3dClusterName(runCtx.KubeContext)
+
+	return r.loadImages(ctx, out, runCtx, artifacts, "k3d", func(tag string) *exec.Cmd {
+		return exec.CommandContext(ctx, "k3d", "image", "import", "--cluster", clusterName, tag)
+	})
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestLoadImagesInK3dNodes(t *testing.T) {
+	tests := []struct {
+		description   string
+		built         []build.Artifact
+		deployed      []build.Artifact
+		commands      util.Command
+		missingK3d    bool
+		shouldErr     bool
+		expectedError string
+	}{
+		{
+			description: "load image",
+			built:       []build.Artifact{{Tag: "tag1"}},
+			deployed:    []build.Artifact{{Tag: "tag1"}},
+			commands: testutil.
+				CmdRunOut("kubectl --context k3d-dev --namespace namespace get nodes -ojsonpath={range .items[*]}{.status.images[*].names[*]}{\"\\n\"}{end}", "").
+				AndRun("k3d image import --cluster dev tag1"),
+		},
+		{
+			description: "load missing image",
+			built:       []build.Artifact{{Tag: "tag1"}, {Tag: "tag2"}},
+			deployed:    []build.Artifact{{Tag: "tag1"}, {Tag: "tag2"}},
+			commands: testutil.
+				CmdRunOut("kubectl --context k3d-dev --namespace namespace get nodes -ojsonpath={range .items[*]}{.status.images[*].names[*]}{\"\\n\"}{end}", "tag1\n").
+				AndRun("k3d image import --cluster dev tag2"),
+		},
+		{
+			description: "load error",
+			built:       []build.Artifact{{Tag: "tag"}},
+			deployed:    []build.Artifact{{Tag: "tag"}},
+			commands: testutil.
+				CmdRunOut("kubectl --context k3d-dev --namespace namespace get nodes -ojsonpath={range .items[*]}{.status.images[*].names[*]}{\"\\n\"}{end}", "").
+				AndRunErr("k3d image import --cluster dev tag", errors.New("BUG")),
+			shouldErr:     true,
+			expectedError: "unable to load image with k3d",
+		},
+		{
+			description:   "missing k3d binary",
+			built:         []build.Artifact{{Tag: "tag"}},
+			deployed:      []build.Artifact{{Tag: "tag"}},
+			missingK3d:    true,
+			shouldErr:     true,
+			expectedError: "`k3d` binary could not be found",
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			t.Override(&lookPath, func(file string) (string, error) {
+				if test.missingK3d {
+					return "", errors.New("executable file not found in $PATH")
+				}
+				return "/usr/local/bin/" + file, nil
+			})
+
+			r := &SkaffoldRunner{
+				builds: test.built,
+				runCtx: &runcontext.RunContext{
+					Opts: config.SkaffoldOptions{
+						Namespace: "namespace",
+					},
+					KubeContext: "k3d-dev",
+				},
+			}
+			err := r.loadImagesInK3dNodes(context.Background(), ioutil.Discard, r.runCtx, test.deployed)
+
+			if test.shouldErr {
+				t.CheckErrorContains(test.expectedError, err)
+			} else {
+				t.CheckNoError(err)
+			}
+		})
+	}
+}
@@ -20,74 +20,14 @@ import (
 	"context"
 	"io"
 	"os/exec"
-	"strings"
-	"time"
-
-	"github.com/pkg/errors"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 )
 
 // loadImagesInKindNodes loads a list of artifact images into every node of kind cluster.
-func (r *SkaffoldRunner) loadImagesInKindNodes(ctx context.Context, out io.Writer, artifacts []build.Artifact) error {
-	start := time.Now()
-	color.Default.Fprintln(out, "Loading images into kind cluster nodes...")
-
-	var knownImages []string
-
-	for _, artifact := range artifacts {
-		// Only `kind load` the images that this runner built
-		if !r.wasBuilt(artifact.Tag) {
-			continue
-		}
-
-		color.Default.Fprintf(out, " - %s -> ", artifact.Tag)
-
-		// Only `kind load` the images that are unknown to the node
-		if knownImages == nil {
-			var err error
-			kubectlCLI := kubectl.NewFromRunContext(r.runCtx)
-			if knownImages, err = findKnownImages(ctx, kubectlCLI); err != nil {
-				return errors.Wrapf(err, "unable to retrieve node's images")
-			}
-		}
-		if util.StrSliceContains(knownImages, artifact.Tag) {
-			color.Green.Fprintln(out, "Found")
-			continue
-		}
-
-		cmd := exec.CommandContext(ctx, "kind", "load", "docker-image", artifact.Tag)
-		if err := util.RunCmd(cmd); err != nil {
-			color.Red.Fprintln(out, "Failed")
-			return errors.Wrapf(err, "unable to load image with kind: %s", artifact.Tag)
-		}
-
-		color.Green.Fprintln(out, "Loaded")
-	}
-
-	color.Default.Fprintln(out, "Images loaded in", time.Since(start))
-	return nil
-}
-
-func findKnownImages(ctx context.Context, cli *kubectl.CLI) ([]string, error) {
-	nodeGetOut, err := cli.RunOut(ctx, "get", "nodes", `-ojsonpath='{@.items[*].status.images[*].names[*]}'`)
-	if err != nil {
-		return nil, errors.Wrapf(err, "unable to inspect the nodes")
-	}
-
-	knownImages := strings.Split(string(nodeGetOut), " ")
-	return knownImages, nil
-}
-
-func (r *SkaffoldRunner) wasBuilt(tag string) bool {
-	for _, built := range r.builds {
-		if built.Tag == tag {
-			return true
-		}
-	}
-
-	return false
+func (r *SkaffoldRunner) loadImagesInKindNodes(ctx context.Context, out io.Writer, runCtx *runcontext.RunContext, artifacts []build.Artifact) error {
+	return r.loadImages(ctx, out, runCtx, artifacts, "kind", func(tag string) *exec.Cmd {
+		return exec.CommandContext(ctx, "kind", "load", "docker-image", tag)
+	})
 }
@@ -44,7 +44,7 @@ func TestLoadImagesInKindNodes(t *testing.T) {
 			built:       []build.Artifact{{Tag: "tag1"}},
 			deployed:    []build.Artifact{{Tag: "tag1"}},
 			commands: testutil.
-				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "").
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath={range .items[*]}{.status.images[*].names[*]}{\"\\n\"}{end}", "").
 				AndRun("kind load docker-image tag1"),
 		},
 		{
@@ -52,15 +52,30 @@ func TestLoadImagesInKindNodes(t *testing.T) {
 			built:       []build.Artifact{{Tag: "tag1"}, {Tag: "tag2"}},
 			deployed:    []build.Artifact{{Tag: "tag1"}, {Tag: "tag2"}},
 			commands: testutil.
-				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "tag1").
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath={range .items[*]}{.status.images[*].names[*]}{\"\\n\"}{end}", "tag1\n").
 				AndRun("kind load docker-image tag2"),
 		},
+		{
+			description: "load image missing on some nodes",
+			built:       []build.Artifact{{Tag: "tag1"}, {Tag: "tag2"}},
+			deployed:    []build.Artifact{{Tag: "tag1"}, {Tag: "tag2"}},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath={range .items[*]}{.status.images[*].names[*]}{\"\\n\"}{end}", "tag1 tag2\ntag1\n").
+				AndRun("kind load docker-image tag2"),
+		},
+		{
+			description: "skip image loaded on all nodes",
+			built:       []build.Artifact{{Tag: "tag1"}},
+			deployed:    []build.Artifact{{Tag: "tag1"}},
+			commands: testutil.
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath={range .items[*]}{.status.images[*].names[*]}{\"\\n\"}{end}", "tag1 other\ntag1\n"),
+		},
 		{
 			description: "inspect error",
 			built:       []build.Artifact{{Tag: "tag"}},
 			deployed:    []build.Artifact{{Tag: "tag"}},
 			commands: testutil.
-				CmdRunOutErr("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "", errors.New("BUG")),
+				CmdRunOutErr("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath={range .items[*]}{.status.images[*].names[*]}{\"\\n\"}{end}", "", errors.New("BUG")),
 			shouldErr:     true,
 			expectedError: "unable to inspect",
 		},
@@ -69,7 +84,7 @@ func TestLoadImagesInKindNodes(t *testing.T) {
 			built:       []build.Artifact{{Tag: "tag"}},
 			deployed:    []build.Artifact{{Tag: "tag"}},
 			commands: testutil.
-				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "").
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath={range .items[*]}{.status.images[*].names[*]}{\"\\n\"}{end}", "").
 				AndRunErr("kind load docker-image tag", errors.New("BUG")),
 			shouldErr:     true,
 			expectedError: "unable to load",
@@ -79,7 +94,7 @@ func TestLoadImagesInKindNodes(t *testing.T) {
 			built:       []build.Artifact{{Tag: "built"}},
 			deployed:    []build.Artifact{{Tag: "built"}, {Tag: "busybox"}},
 			commands: testutil.
-				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath='{@.items[*].status.images[*].names[*]}'", "").
+				CmdRunOut("kubectl --context kubecontext --namespace namespace get nodes -ojsonpath={range .items[*]}{.status.images[*].names[*]}{\"\\n\"}{end}", "").
 				AndRun("kind load docker-image built"),
 		},
 		{
@@ -106,7 +121,7 @@ func TestLoadImagesInKindNodes(t *testing.T) {
 					KubeContext: "kubecontext",
 				},
 			}
-			err := r.loadImagesInKindNodes(context.Background(), ioutil.Discard, test.deployed)
+			err := r.loadImagesInKindNodes(context.Background(), ioutil.Discard, r.runCtx, test.deployed)
 
 			if test.shouldErr {
 				t.CheckErrorContains(test.expectedError, err)
@@ -0,0 +1,122 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// for testing
+var lookPath = exec.LookPath
+
+// loadImages loads a list of artifact images into the cluster's nodes
+// with a cluster specific CLI.
+func (r *SkaffoldRunner) loadImages(ctx context.Context, out io.Writer, runCtx *runcontext.RunContext, artifacts []build.Artifact, cli string, loadCmd func(tag string) *exec.Cmd) error {
+	start := time.Now()
+	color.Default.Fprintf(out, "Loading images into %s cluster nodes...\n", cli)
+
+	var knownImages []string
+
+	for _, artifact := range artifacts {
+		// Only load the images that this runner built
+		if !r.wasBuilt(artifact.Tag) {
+			continue
+		}
+
+		color.Default.Fprintf(out, " - %s -> ", artifact.Tag)
+
+		// Only load the images that are unknown to the node
+		if knownImages == nil {
+			var err error
+			kubectlCLI := kubectl.NewFromRunContext(runCtx)
+			if knownImages, err = findKnownImages(ctx, kubectlCLI); err != nil {
+				return errors.Wrapf(err, "unable to retrieve node's images")
+			}
+		}
+		if util.StrSliceContains(knownImages, artifact.Tag) {
+			logrus.Debugf("Skipping %s, already loaded on all the nodes", artifact.Tag)
+			color.Green.Fprintln(out, "Found")
+			continue
+		}
+
+		if err := util.RunCmd(loadCmd(artifact.Tag)); err != nil {
+			color.Red.Fprintln(out, "Failed")
+			return errors.Wrapf(err, "unable to load image with %s: %s", cli, artifact.Tag)
+		}
+
+		color.Green.Fprintln(out, "Loaded")
+	}
+
+	color.Default.Fprintln(out, "Images loaded in", time.Since(start))
+	return nil
+}
+
+// findKnownImages lists the images that are present on all the nodes.
+// An image that's missing from a single node has to be loaded again.
+func findKnownImages(ctx context.Context, cli *kubectl.CLI) ([]string, error) {
+	// One line per node, with the names of its images.
+	nodeGetOut, err := cli.RunOut(ctx, "get", "nodes", `-ojsonpath={range .items[*]}{.status.images[*].names[*]}{"\n"}{end}`)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to inspect the nodes")
+	}
+
+	var knownImages []string
+	for i, line := range strings.Split(strings.TrimSuffix(string(nodeGetOut), "\n"), "\n") {
+		nodeImages := strings.Fields(line)
+		if i == 0 {
+			knownImages = nodeImages
+			continue
+		}
+
+		var onAllNodes []string
+		for _, image := range knownImages {
+			if util.StrSliceContains(nodeImages, image) {
+				onAllNodes = append(onAllNodes, image)
+			}
+		}
+		knownImages = onAllNodes
+	}
+
+	// Not nil, so that the nodes are inspected only once.
+	if knownImages == nil {
+		knownImages = []string{}
+	}
+	return knownImages, nil
+}
+
+func (r *SkaffoldRunner) wasBuilt(tag string) bool {
+	for _, built := range r.builds {
+		if built.Tag == tag {
+			return true
+		}
+	}
+
+	return false
+}
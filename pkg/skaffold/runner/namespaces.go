@@ -0,0 +1,157 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
+	pkgkubernetes "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+)
+
+// For testing
+var kubernetesClient = func(runCtx *runcontext.RunContext) (kubernetes.Interface, error) {
+	if len(runCtx.Opts.KubeContexts) > 0 {
+		return pkgkubernetes.ClientForContext(runCtx.KubeContext)
+	}
+	return pkgkubernetes.Client()
+}
+
+// targetNamespaces lists the namespaces that the deployer targets: those of the
+// rendered manifests and those that were found in the configuration or
+// in the results of previous deployments.
+func targetNamespaces(ctx context.Context, runCtx *runcontext.RunContext, deployer deploy.Deployer, artifacts []build.Artifact) []string {
+	set := map[string]bool{}
+	for _, ns := range runCtx.Namespaces {
+		set[ns] = true
+	}
+
+	var manifests bytes.Buffer
+	if err := deployer.Render(ctx, &manifests, artifacts, ""); err != nil {
+		logrus.Debugf("unable to render manifests: %s", err)
+	} else {
+		var l kubectl.ManifestList
+		l.Append(manifests.Bytes())
+
+		namespaces, err := l.CollectNamespaces()
+		if err != nil {
+			logrus.Debugf("unable to collect namespaces: %s", err)
+		}
+		for _, ns := range namespaces {
+			set[ns] = true
+		}
+	}
+
+	var namespaces []string
+	for ns := range set {
+		if ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// createNamespaces creates the target namespaces that don't exist yet.
+// Created namespaces are labeled so that they can be deleted on cleanup.
+// They also get the static labels given by the user.
+func (r *SkaffoldRunner) createNamespaces(ctx context.Context, out io.Writer, runCtx *runcontext.RunContext, deployer deploy.Deployer, artifacts []build.Artifact) error {
+	namespaces := targetNamespaces(ctx, runCtx, deployer, artifacts)
+	if len(namespaces) == 0 {
+		return nil
+	}
+
+	client, err := kubernetesClient(runCtx)
+	if err != nil {
+		return errors.Wrap(err, "getting Kubernetes client")
+	}
+
+	for _, ns := range namespaces {
+		if _, err := client.CoreV1().Namespaces().Get(ns, metav1.GetOptions{}); err == nil {
+			continue
+		} else if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "getting namespace %s", ns)
+		}
+
+		_, err := client.CoreV1().Namespaces().Create(&v1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   ns,
+				Labels: labels.Merge(runCtx.Opts.StaticLabels, r.defaultLabeller.Labels()),
+			},
+		})
+		switch {
+		case apierrors.IsAlreadyExists(err):
+			// Created concurrently, by someone else.
+		case err != nil:
+			return errors.Wrapf(err, "creating namespace %s", ns)
+		default:
+			color.Default.Fprintln(out, " - created namespace", ns)
+		}
+	}
+
+	return nil
+}
+
+// deleteCreatedNamespaces deletes the target namespaces that were created by Skaffold.
+// Namespaces that existed before aren't labeled by Skaffold and are left untouched.
+func (r *SkaffoldRunner) deleteCreatedNamespaces(ctx context.Context, out io.Writer, runCtx *runcontext.RunContext, deployer deploy.Deployer) error {
+	namespaces := targetNamespaces(ctx, runCtx, deployer, r.builds)
+	if len(namespaces) == 0 {
+		return nil
+	}
+
+	client, err := kubernetesClient(runCtx)
+	if err != nil {
+		return errors.Wrap(err, "getting Kubernetes client")
+	}
+
+	for _, ns := range namespaces {
+		namespace, err := client.CoreV1().Namespaces().Get(ns, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return errors.Wrapf(err, "getting namespace %s", ns)
+		}
+
+		if !strings.HasPrefix(namespace.Labels[deploy.K8sManagedByLabelKey], "skaffold-") {
+			continue
+		}
+
+		if err := client.CoreV1().Namespaces().Delete(ns, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "deleting namespace %s", ns)
+		}
+		color.Default.Fprintln(out, " - deleted namespace", ns)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+// manifestsDeployer renders fixed manifests.
+type manifestsDeployer struct {
+	*TestBench
+	manifests string
+}
+
+func (d *manifestsDeployer) Render(_ context.Context, out io.Writer, _ []build.Artifact, _ string) error {
+	_, err := io.WriteString(out, d.manifests)
+	return err
+}
+
+func namespace(name string, labels map[string]string) *v1.Namespace {
+	return &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func TestCreateNamespaces(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		client := fakekubeclientset.NewSimpleClientset(namespace("existing", nil))
+		t.Override(&kubernetesClient, func(*runcontext.RunContext) (kubernetes.Interface, error) { return client, nil })
+
+		runCtx := &runcontext.RunContext{
+			Opts: config.SkaffoldOptions{
+				CreateNamespaces: true,
+				StaticLabels: map[string]string{
+					"ci/build-id":               "42",
+					deploy.K8sManagedByLabelKey: "ci",
+				},
+			},
+			Namespaces: []string{"", "release"},
+		}
+		deployer := &manifestsDeployer{
+			TestBench: &TestBench{},
+			manifests: `apiVersion: v1
+kind: Pod
+metadata:
+  name: pod1
+  namespace: existing
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: pod2
+  namespace: missing
+`,
+		}
+		r := &SkaffoldRunner{defaultLabeller: deploy.NewLabeller("test")}
+
+		var out bytes.Buffer
+		err := r.createNamespaces(context.Background(), &out, runCtx, deployer, nil)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(" - created namespace missing\n - created namespace release\n", out.String())
+
+		created, err := client.CoreV1().Namespaces().Get("missing", metav1.GetOptions{})
+		t.CheckNoError(err)
+		t.CheckDeepEqual("skaffold-test", created.Labels[deploy.K8sManagedByLabelKey])
+		t.CheckDeepEqual("42", created.Labels["ci/build-id"])
+
+		existing, err := client.CoreV1().Namespaces().Get("existing", metav1.GetOptions{})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(0, len(existing.Labels))
+
+		// Creating namespaces is idempotent.
+		out.Reset()
+		err = r.createNamespaces(context.Background(), &out, runCtx, deployer, nil)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("", out.String())
+	})
+}
+
+func TestDeleteCreatedNamespaces(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		objs := []runtime.Object{
+			namespace("existing", nil),
+			namespace("other-tool", map[string]string{deploy.K8sManagedByLabelKey: "helm"}),
+			namespace("created", map[string]string{deploy.K8sManagedByLabelKey: "skaffold-v1.0.0"}),
+			namespace("not-targeted", map[string]string{deploy.K8sManagedByLabelKey: "skaffold-v1.0.0"}),
+		}
+		client := fakekubeclientset.NewSimpleClientset(objs...)
+		t.Override(&kubernetesClient, func(*runcontext.RunContext) (kubernetes.Interface, error) { return client, nil })
+
+		runCtx := &runcontext.RunContext{
+			Opts:       config.SkaffoldOptions{CreateNamespaces: true},
+			Namespaces: []string{"existing", "other-tool", "created", "missing"},
+		}
+		r := &SkaffoldRunner{}
+
+		var out bytes.Buffer
+		err := r.deleteCreatedNamespaces(context.Background(), &out, runCtx, &TestBench{})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(" - deleted namespace created\n", out.String())
+
+		namespaces, err := client.CoreV1().Namespaces().List(metav1.ListOptions{})
+		t.CheckNoError(err)
+		var remaining []string
+		for _, ns := range namespaces.Items {
+			remaining = append(remaining, ns.Name)
+		}
+		sort.Strings(remaining)
+		t.CheckDeepEqual([]string{"existing", "not-targeted", "other-tool"}, remaining)
+	})
+}
@@ -42,6 +42,10 @@ import (
 
 // NewForConfig returns a new SkaffoldRunner for a SkaffoldConfig
 func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
+	if err := checkSelectedArtifacts(runCtx.Opts.Only, runCtx.Cfg.Build.Artifacts); err != nil {
+		return nil, err
+	}
+
 	tagger, err := getTagger(runCtx)
 	if err != nil {
 		return nil, errors.Wrap(err, "parsing tag config")
@@ -70,9 +74,15 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 		return nil, errors.Wrap(err, "parsing deploy config")
 	}
 
+	kubeContextDeployers, err := getKubeContextDeployers(runCtx)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing deploy config")
+	}
+
 	defaultLabeller := deploy.NewLabeller("")
+	// Static labels are first so that Skaffold's own labels win.
 	// runCtx.Opts is last to let users override/remove any label
-	labellers := []deploy.Labeller{builder, deployer, tagger, defaultLabeller, &runCtx.Opts}
+	labellers := []deploy.Labeller{deploy.StaticLabeller(runCtx.Opts.StaticLabels), builder, deployer, tagger, defaultLabeller, &runCtx.Opts}
 
 	builder, tester, deployer = WithTimings(builder, tester, deployer, runCtx.Opts.CacheArtifacts)
 	if runCtx.Opts.Notification {
@@ -114,6 +124,7 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 		runCtx:               runCtx,
 		intents:              newIntents(runCtx.Opts.AutoBuild, runCtx.Opts.AutoSync, runCtx.Opts.AutoDeploy),
 		imagesAreLocal:       imagesAreLocal,
+		kubeContextDeployers: kubeContextDeployers,
 	}
 
 	if err := r.setupTriggerCallbacks(intentChan); err != nil {
@@ -203,21 +214,56 @@ func getSyncer(runCtx *runcontext.RunContext) sync.Syncer {
 }
 
 func getDeployer(runCtx *runcontext.RunContext) (deploy.Deployer, error) {
-	switch {
-	case runCtx.Cfg.Deploy.HelmDeploy != nil:
-		return deploy.NewHelmDeployer(runCtx), nil
+	var deployers []deploy.Deployer
+
+	if runCtx.Cfg.Deploy.HelmDeploy != nil {
+		deployers = append(deployers, deploy.NewHelmDeployer(runCtx))
+	}
 
-	case runCtx.Cfg.Deploy.KubectlDeploy != nil:
-		return deploy.NewKubectlDeployer(runCtx), nil
+	if runCtx.Cfg.Deploy.KubectlDeploy != nil {
+		deployers = append(deployers, deploy.NewKubectlDeployer(runCtx))
+	}
 
-	case runCtx.Cfg.Deploy.KustomizeDeploy != nil:
-		return deploy.NewKustomizeDeployer(runCtx), nil
+	if runCtx.Cfg.Deploy.KustomizeDeploy != nil {
+		deployers = append(deployers, deploy.NewKustomizeDeployer(runCtx))
+	}
 
-	default:
+	switch len(deployers) {
+	case 0:
 		return nil, fmt.Errorf("unknown deployer for config %+v", runCtx.Cfg.Deploy)
+	case 1:
+		return deployers[0], nil
+	default:
+		return deploy.NewDeployerMux(deployers, runCtx.Opts.DeployConcurrency), nil
 	}
 }
 
+// getKubeContextDeployers creates a deployer for each of the kube-contexts
+// that should be deployed to, if any.
+func getKubeContextDeployers(runCtx *runcontext.RunContext) ([]kubeContextDeployer, error) {
+	var deployers []kubeContextDeployer
+
+	for _, kubeContext := range runCtx.Opts.KubeContexts {
+		contextRunCtx := *runCtx
+		contextRunCtx.KubeContext = kubeContext
+
+		deployer, err := getDeployer(&contextRunCtx)
+		if err != nil {
+			return nil, err
+		}
+		if runCtx.Opts.Notification {
+			deployer = WithNotification(deployer)
+		}
+
+		deployers = append(deployers, kubeContextDeployer{
+			runCtx:   &contextRunCtx,
+			deployer: deployer,
+		})
+	}
+
+	return deployers, nil
+}
+
 func getTagger(runCtx *runcontext.RunContext) (tag.Tagger, error) {
 	t := runCtx.Cfg.Build.TagPolicy
 
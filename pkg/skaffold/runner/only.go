@@ -0,0 +1,77 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package runner
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+)
+
+// checkSelectedArtifacts makes sure that the artifacts selected with --only are defined in the config.
+func checkSelectedArtifacts(only []string, artifacts []*latest.Artifact) error {
+	defined := map[string]bool{}
+	var names []string
+	for _, artifact := range artifacts {
+		defined[artifact.ImageName] = true
+		names = append(names, artifact.ImageName)
+	}
+
+	var unknown []string
+	for _, imageName := range only {
+		if !defined[imageName] {
+			unknown = append(unknown, imageName)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown artifact(s) selected with --only: %s. Available artifacts are: %s", strings.Join(unknown, ", "), strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// selectArtifacts filters the artifacts that were selected with --only.
+func selectArtifacts(opts config.SkaffoldOptions, artifacts []*latest.Artifact) []*latest.Artifact {
+	if len(opts.Only) == 0 {
+		return artifacts
+	}
+
+	var selected []*latest.Artifact
+	for _, artifact := range artifacts {
+		if opts.IsSelectedArtifact(artifact.ImageName) {
+			selected = append(selected, artifact)
+		}
+	}
+	return selected
+}
+
+// selectBuilds filters the builds of the artifacts that were selected with --only.
+func selectBuilds(opts config.SkaffoldOptions, builds []build.Artifact) []build.Artifact {
+	if len(opts.Only) == 0 {
+		return builds
+	}
+
+	var selected []build.Artifact
+	for _, b := range builds {
+		if opts.IsSelectedArtifact(b.ImageName) {
+			selected = append(selected, b)
+		}
+	}
+	return selected
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package runner
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestCheckSelectedArtifacts(t *testing.T) {
+	artifacts := []*latest.Artifact{{ImageName: "frontend"}, {ImageName: "backend"}}
+
+	tests := []struct {
+		description   string
+		only          []string
+		shouldErr     bool
+		expectedError string
+	}{
+		{
+			description: "no selection",
+		},
+		{
+			description: "existing artifacts",
+			only:        []string{"backend"},
+		},
+		{
+			description:   "unknown artifact",
+			only:          []string{"backend", "back"},
+			shouldErr:     true,
+			expectedError: "unknown artifact(s) selected with --only: back. Available artifacts are: frontend, backend",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			err := checkSelectedArtifacts(test.only, artifacts)
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				t.CheckErrorContains(test.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestSelectArtifacts(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		opts := config.SkaffoldOptions{Only: []string{"backend"}}
+
+		artifacts := selectArtifacts(opts, []*latest.Artifact{{ImageName: "frontend"}, {ImageName: "backend"}})
+		builds := selectBuilds(opts, []build.Artifact{{ImageName: "frontend", Tag: "frontend:v1"}, {ImageName: "backend", Tag: "backend:v1"}})
+
+		t.CheckDeepEqual([]*latest.Artifact{{ImageName: "backend"}}, artifacts)
+		t.CheckDeepEqual([]build.Artifact{{ImageName: "backend", Tag: "backend:v1"}}, builds)
+	})
+}
@@ -18,9 +18,89 @@ package runner
 
 import (
 	"context"
+	"fmt"
 	"io"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/go-units"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 )
 
 func (r *SkaffoldRunner) Prune(ctx context.Context, out io.Writer) error {
 	return r.builder.Prune(ctx, out)
 }
+
+// pruneBuiltImages removes the local images built by previous iterations that
+// are not deployed anymore. Only images that this runner has built are considered.
+// Failing to prune an image doesn't fail the deploy: it will be tried again
+// after the next deploy.
+func (r *SkaffoldRunner) pruneBuiltImages(ctx context.Context, out io.Writer, deployed []build.Artifact) {
+	inUse := map[string]bool{}
+	for _, a := range append(deployed, r.builds...) {
+		inUse[a.Tag] = true
+	}
+
+	var candidates, kept []string
+	for _, tag := range r.builtImages {
+		if inUse[tag] {
+			kept = append(kept, tag)
+		} else {
+			candidates = append(candidates, tag)
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	localDocker, err := docker.NewAPIClient(r.runCtx)
+	if err != nil {
+		logrus.Warnln("Unable to prune images:", err)
+		return
+	}
+
+	dryRun := r.runCtx.Opts.PruneDryRun
+	var pruned int
+	var reclaimed int64
+	for _, tag := range candidates {
+		inspect, _, err := localDocker.ImageInspectWithRaw(ctx, tag)
+		if err != nil {
+			logrus.Debugf("Not pruning %s: %v", tag, err)
+			continue
+		}
+
+		if dryRun {
+			fmt.Fprintf(out, " - %s would be pruned\n", tag)
+			kept = append(kept, tag)
+			pruned++
+			reclaimed += inspect.Size
+			continue
+		}
+
+		resp, err := localDocker.ImageRemove(ctx, tag, types.ImageRemoveOptions{PruneChildren: true})
+		if err != nil {
+			logrus.Warnf("Unable to prune %s: %v", tag, err)
+			kept = append(kept, tag)
+			continue
+		}
+
+		pruned++
+		for _, item := range resp {
+			// The image might only be untagged if it's still referenced by another tag.
+			if item.Deleted != "" {
+				reclaimed += inspect.Size
+				break
+			}
+		}
+	}
+	r.builtImages = kept
+
+	if dryRun {
+		color.Default.Fprintf(out, "%d image(s) would be pruned, reclaiming up to %s\n", pruned, units.HumanSize(float64(reclaimed)))
+	} else {
+		color.Default.Fprintf(out, "Pruned %d image(s), reclaimed %s\n", pruned, units.HumanSize(float64(reclaimed)))
+	}
+}
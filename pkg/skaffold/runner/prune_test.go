@@ -0,0 +1,91 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package runner
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestPruneBuiltImages(t *testing.T) {
+	tests := []struct {
+		description     string
+		builtImages     []string
+		deployed        []build.Artifact
+		dryRun          bool
+		expectedRemoved []string
+		expectedBuilt   []string
+		expectedOutput  string
+	}{
+		{
+			description:   "nothing to prune",
+			builtImages:   []string{"image:v2"},
+			deployed:      []build.Artifact{{ImageName: "image", Tag: "image:v2"}},
+			expectedBuilt: []string{"image:v2"},
+		},
+		{
+			description:     "prune images from previous iterations",
+			builtImages:     []string{"image:v1", "image:v2", "other:v1"},
+			deployed:        []build.Artifact{{ImageName: "image", Tag: "image:v2"}, {ImageName: "other", Tag: "other:v1"}},
+			expectedRemoved: []string{"image:v1"},
+			expectedBuilt:   []string{"image:v2", "other:v1"},
+			expectedOutput:  "Pruned 1 image(s), reclaimed 0B\n",
+		},
+		{
+			description:    "dry-run",
+			builtImages:    []string{"image:v1", "image:v2"},
+			deployed:       []build.Artifact{{ImageName: "image", Tag: "image:v2"}},
+			dryRun:         true,
+			expectedBuilt:  []string{"image:v2", "image:v1"},
+			expectedOutput: " - image:v1 would be pruned\n1 image(s) would be pruned, reclaiming up to 0B\n",
+		},
+		{
+			description:    "ignore images that are already gone",
+			builtImages:    []string{"gone:v1", "image:v2"},
+			deployed:       []build.Artifact{{ImageName: "image", Tag: "image:v2"}},
+			expectedBuilt:  []string{"image:v2"},
+			expectedOutput: "Pruned 0 image(s), reclaimed 0B\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			api := (&testutil.FakeAPIClient{}).Add("image:v1", "sha256:1").Add("image:v2", "sha256:2").Add("other:v1", "sha256:3")
+			t.Override(&docker.NewAPIClient, func(*runcontext.RunContext) (docker.LocalDaemon, error) {
+				return docker.NewLocalDaemon(api, nil, false, nil), nil
+			})
+
+			r := &SkaffoldRunner{
+				builtImages: test.builtImages,
+				runCtx: &runcontext.RunContext{
+					Opts: config.SkaffoldOptions{PruneAfterDeploy: true, PruneDryRun: test.dryRun},
+				},
+			}
+			var out bytes.Buffer
+			r.pruneBuiltImages(context.Background(), &out, test.deployed)
+
+			t.CheckDeepEqual(test.expectedRemoved, api.Removed)
+			t.CheckDeepEqual(test.expectedBuilt, r.builtImages)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+		})
+	}
+}
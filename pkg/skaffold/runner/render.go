@@ -17,12 +17,98 @@ limitations under the License.
 package runner
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	deploy "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 )
 
 func (r *SkaffoldRunner) Render(ctx context.Context, out io.Writer, builds []build.Artifact, filepath string) error {
+	if r.runCtx.Opts.SplitManifests {
+		return r.renderToDirectory(ctx, out, builds, filepath)
+	}
+
 	return r.deployer.Render(ctx, out, builds, filepath)
 }
+
+// renderToDirectory writes each rendered kubernetes object to its own file,
+// named after its kind and name.
+func (r *SkaffoldRunner) renderToDirectory(ctx context.Context, out io.Writer, builds []build.Artifact, dir string) error {
+	if dir == "" {
+		return errors.New("an output directory is required to split the rendered manifests")
+	}
+
+	var buf bytes.Buffer
+	if err := r.deployer.Render(ctx, &buf, builds, ""); err != nil {
+		return err
+	}
+
+	var manifests deploy.ManifestList
+	manifests.Append(buf.Bytes())
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrap(err, "creating output directory")
+	}
+
+	written := map[string]bool{}
+	for _, manifest := range manifests {
+		manifest = bytes.TrimSpace(manifest)
+		if len(manifest) == 0 {
+			continue
+		}
+
+		filename, err := manifestFilename(manifest, written)
+		if err != nil {
+			return err
+		}
+		written[filename] = true
+
+		path := filepath.Join(dir, filename)
+		if err := ioutil.WriteFile(path, append(manifest, '\n'), 0644); err != nil {
+			return errors.Wrapf(err, "writing manifest to %s", path)
+		}
+		color.Default.Fprintln(out, "Wrote", path)
+	}
+
+	return nil
+}
+
+// manifestFilename names a file after the kind and name of the object it contains.
+// The namespace is added to the file name for objects that have the same kind and name.
+func manifestFilename(manifest []byte, written map[string]bool) (string, error) {
+	var object struct {
+		Kind     string `yaml:"kind"`
+		Metadata struct {
+			Name      string `yaml:"name"`
+			Namespace string `yaml:"namespace"`
+		} `yaml:"metadata"`
+	}
+	if err := yaml.Unmarshal(manifest, &object); err != nil {
+		return "", errors.Wrap(err, "reading manifest")
+	}
+	if object.Kind == "" || object.Metadata.Name == "" {
+		return "", fmt.Errorf("manifest is missing a kind or a name:\n%s", manifest)
+	}
+
+	kind := strings.ToLower(object.Kind)
+	filename := fmt.Sprintf("%s-%s.yaml", kind, object.Metadata.Name)
+	if written[filename] && object.Metadata.Namespace != "" {
+		filename = fmt.Sprintf("%s-%s-%s.yaml", kind, object.Metadata.Namespace, object.Metadata.Name)
+	}
+	if written[filename] {
+		return "", fmt.Errorf("more than one manifest would be written to %s", filename)
+	}
+
+	return filename, nil
+}
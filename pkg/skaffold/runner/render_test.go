@@ -0,0 +1,117 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type manifestsRenderer struct {
+	*TestBench
+	manifests string
+}
+
+func (r *manifestsRenderer) Render(_ context.Context, out io.Writer, _ []build.Artifact, _ string) error {
+	fmt.Fprintln(out, r.manifests)
+	return nil
+}
+
+func TestRenderToDirectory(t *testing.T) {
+	tests := []struct {
+		description   string
+		manifests     string
+		expectedFiles []string
+		shouldErr     bool
+	}{
+		{
+			description: "one file per object",
+			manifests: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web`,
+			expectedFiles: []string{"deployment-web.yaml", "service-web.yaml"},
+		},
+		{
+			description: "same name in different namespaces",
+			manifests: `apiVersion: v1
+kind: Service
+metadata:
+  name: web
+  namespace: ns1
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+  namespace: ns2`,
+			expectedFiles: []string{"service-web.yaml", "service-ns2-web.yaml"},
+		},
+		{
+			description: "object without a name",
+			manifests: `apiVersion: v1
+kind: Service`,
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			runner := &SkaffoldRunner{
+				deployer: &manifestsRenderer{manifests: test.manifests},
+				runCtx: &runcontext.RunContext{
+					Opts: config.SkaffoldOptions{SplitManifests: true},
+				},
+			}
+
+			err := runner.Render(context.Background(), ioutil.Discard, nil, tmpDir.Root())
+
+			t.CheckError(test.shouldErr, err)
+			for _, file := range test.expectedFiles {
+				content, err := ioutil.ReadFile(tmpDir.Path(file))
+				t.CheckNoError(err)
+				t.CheckContains("kind:", string(content))
+			}
+		})
+	}
+}
+
+func TestRenderToDirectoryRequiresOutput(t *testing.T) {
+	runner := &SkaffoldRunner{
+		deployer: &manifestsRenderer{},
+		runCtx: &runcontext.RunContext{
+			Opts: config.SkaffoldOptions{SplitManifests: true},
+		},
+	}
+
+	err := runner.Render(context.Background(), ioutil.Discard, nil, "")
+
+	testutil.CheckError(t, true, err)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/proto"
+)
+
+// For testing
+var runComplete = event.RunComplete
+
+// notifyRunComplete sends, with `skaffold run` only, the event that ends the run.
+// It references the deployed images and the resources that were created or updated.
+func (r *SkaffoldRunner) notifyRunComplete(artifacts []build.Artifact, err error) {
+	if r.runCtx.Opts.Command != "run" {
+		return
+	}
+
+	images := map[string]string{}
+	for _, a := range artifacts {
+		images[a.ImageName] = a.Tag
+	}
+
+	var resources []*proto.DeployedResource
+	if err == nil {
+		for _, res := range r.deployedResources {
+			apiVersion, kind := res.GVK.ToAPIVersionAndKind()
+			resources = append(resources, &proto.DeployedResource{
+				ApiVersion: apiVersion,
+				Kind:       kind,
+				Namespace:  res.Namespace,
+				Name:       res.Name,
+			})
+		}
+	}
+
+	runComplete(images, resources, err)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
+	"github.com/GoogleContainerTools/skaffold/proto"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestNotifyRunComplete(t *testing.T) {
+	tests := []struct {
+		description       string
+		command           string
+		deployErrors      []error
+		expectedNotified  bool
+		expectedErr       bool
+		expectedImages    map[string]string
+		expectedResources []*proto.DeployedResource
+	}{
+		{
+			description:      "run succeeded",
+			command:          "run",
+			expectedNotified: true,
+			expectedImages:   map[string]string{"img": "img:tag"},
+			expectedResources: []*proto.DeployedResource{
+				{ApiVersion: "apps/v1", Kind: "Deployment", Namespace: "ns", Name: "app"},
+			},
+		},
+		{
+			description:      "run failed",
+			command:          "run",
+			deployErrors:     []error{errors.New("deploy failed")},
+			expectedNotified: true,
+			expectedErr:      true,
+			expectedImages:   map[string]string{"img": "img:tag"},
+		},
+		{
+			description: "not in run mode",
+			command:     "deploy",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetupFakeKubernetesContext(api.Config{CurrentContext: "cluster1"})
+			var (
+				notified  bool
+				images    map[string]string
+				resources []*proto.DeployedResource
+				runErr    error
+			)
+			t.Override(&runComplete, func(i map[string]string, r []*proto.DeployedResource, err error) {
+				notified, images, resources, runErr = true, i, r, err
+			})
+
+			runner := createRunner(t, NewTestBench().WithDeployErrors(test.deployErrors), nil)
+			runner.runCtx.Opts.Command = test.command
+			runner.deployer = &resourcesDeployer{
+				Deployer: runner.deployer,
+				resources: []deploy.AppliedResource{
+					{GVK: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, Namespace: "ns", Name: "app"},
+				},
+			}
+
+			err := runner.DeployAndLog(context.Background(), ioutil.Discard, []build.Artifact{{ImageName: "img", Tag: "img:tag"}})
+
+			t.CheckError(test.expectedErr, err)
+			t.CheckDeepEqual(test.expectedNotified, notified)
+			t.CheckDeepEqual(test.expectedErr, runErr != nil)
+			t.CheckDeepEqual(test.expectedImages, images)
+			t.CheckDeepEqual(test.expectedResources, resources)
+		})
+	}
+}
+
+// resourcesDeployer reports the given resources for each successful deploy.
+type resourcesDeployer struct {
+	deploy.Deployer
+	resources []deploy.AppliedResource
+}
+
+func (d *resourcesDeployer) Deploy(ctx context.Context, out io.Writer, builds []build.Artifact, labellers []deploy.Labeller) *deploy.Result {
+	result := d.Deployer.Deploy(ctx, out, builds, labellers)
+	if result.GetError() != nil {
+		return result
+	}
+	return result.WithResources(d.resources)
+}
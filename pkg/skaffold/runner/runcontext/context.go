@@ -17,11 +17,13 @@ limitations under the License.
 package runcontext
 
 import (
+	"fmt"
 	"os"
 	"sort"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
@@ -46,6 +48,11 @@ func GetRunContext(opts config.SkaffoldOptions, cfg latest.Pipeline) (*RunContex
 		return nil, errors.Wrap(err, "getting current cluster context")
 	}
 	kubeContext := kubeConfig.CurrentContext
+	if opts.KubeConfig != "" {
+		if err := validateKubeConfig(opts.KubeConfig, kubeConfig, kubeContext); err != nil {
+			return nil, err
+		}
+	}
 	logrus.Infof("Using kubectl context: %s", kubeContext)
 
 	// TODO(dgageot): this should be the folder containing skaffold.yaml. Should also be moved elsewhere.
@@ -87,6 +94,18 @@ func GetRunContext(opts config.SkaffoldOptions, cfg latest.Pipeline) (*RunContex
 	}, nil
 }
 
+// validateKubeConfig checks that an explicit kubeconfig file exists
+// and that it defines the kube-context to be used.
+func validateKubeConfig(path string, kubeConfig clientcmdapi.Config, kubeContext string) error {
+	if _, err := os.Stat(path); err != nil {
+		return errors.Wrap(err, "reading kubeconfig")
+	}
+	if _, found := kubeConfig.Contexts[kubeContext]; !found {
+		return fmt.Errorf("kube-context %q not found in kubeconfig %s", kubeContext, path)
+	}
+	return nil
+}
+
 func (r *RunContext) UpdateNamespaces(ns []string) {
 	if len(ns) == 0 {
 		return
@@ -19,6 +19,8 @@ package runcontext
 import (
 	"testing"
 
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -67,3 +69,44 @@ func TestRunContext_UpdateNamespaces(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateKubeConfig(t *testing.T) {
+	kubeConfig := clientcmdapi.Config{
+		Contexts: map[string]*clientcmdapi.Context{"kind-kind": {}},
+	}
+
+	tests := []struct {
+		description string
+		missingFile bool
+		kubeContext string
+		shouldErr   bool
+	}{
+		{
+			description: "valid",
+			kubeContext: "kind-kind",
+		},
+		{
+			description: "missing file",
+			missingFile: true,
+			kubeContext: "kind-kind",
+			shouldErr:   true,
+		},
+		{
+			description: "unknown kube-context",
+			kubeContext: "minikube",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			path := t.NewTempDir().Touch("kubeconfig").Path("kubeconfig")
+			if test.missingFile {
+				path += ".missing"
+			}
+
+			err := validateKubeConfig(path, kubeConfig, test.kubeContext)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
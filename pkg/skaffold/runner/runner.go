@@ -71,6 +71,26 @@ type SkaffoldRunner struct {
 	hasBuilt             bool
 	hasDeployed          bool
 	intents              *intents
+
+	// builtImages are the images built by this runner, that
+	// --prune-after-deploy can remove once they're not deployed anymore.
+	builtImages []string
+
+	// deployedManifestsHash is the hash of the manifests that were last deployed.
+	deployedManifestsHash string
+
+	// deployedResources are the resources created or updated by the last deploy.
+	deployedResources []deploy.AppliedResource
+
+	// kubeContextDeployers are used instead of the deployer when
+	// deploying to multiple kube-contexts.
+	kubeContextDeployers []kubeContextDeployer
+}
+
+// kubeContextDeployer deploys to a single kube-context.
+type kubeContextDeployer struct {
+	runCtx   *runcontext.RunContext
+	deployer deploy.Deployer
 }
 
 // for testing
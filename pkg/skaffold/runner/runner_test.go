@@ -51,6 +51,7 @@ type TestBench struct {
 	testErrors   []error
 	deployErrors []error
 	namespaces   []string
+	renderTags   bool
 
 	devLoop        func(context.Context, io.Writer) error
 	firstMonitor   func(bool) error
@@ -85,6 +86,11 @@ func (t *TestBench) WithDeployNamespaces(ns []string) *TestBench {
 	return t
 }
 
+func (t *TestBench) WithRenderedTags() *TestBench {
+	t.renderTags = true
+	return t
+}
+
 func (t *TestBench) WithTestErrors(testErrors []error) *TestBench {
 	t.testErrors = testErrors
 	return t
@@ -170,7 +176,10 @@ func (t *TestBench) Deploy(_ context.Context, _ io.Writer, artifacts []build.Art
 	return deploy.NewDeploySuccessResult(t.namespaces)
 }
 
-func (t *TestBench) Render(_ context.Context, _ io.Writer, artifacts []build.Artifact, _ string) error {
+func (t *TestBench) Render(_ context.Context, out io.Writer, artifacts []build.Artifact, _ string) error {
+	if t.renderTags {
+		fmt.Fprintln(out, findTags(artifacts))
+	}
 	return nil
 }
 
@@ -0,0 +1,67 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/color"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+)
+
+// gracefulContext is a context that outlives the cancellation of its parent
+// for a limited time but still carries its parent's values.
+type gracefulContext struct {
+	context.Context
+	parent context.Context
+}
+
+func (c *gracefulContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
+
+// withGracefulShutdown returns a context that's cancelled only after the given timeout
+// has elapsed since ctx was cancelled. It lets a deploy in progress complete, so that
+// the cluster is not left with a half-applied set of manifests.
+// With a timeout of zero, ctx is returned and the deploy is aborted immediately.
+func withGracefulShutdown(ctx context.Context, out io.Writer, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+
+	detached, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-detached.Done():
+			return
+		case <-ctx.Done():
+		}
+
+		color.Yellow.Fprintf(out, "Interrupted, waiting up to %v for the deploy to complete...\n", timeout)
+		event.DeployShuttingDown()
+
+		select {
+		case <-detached.Done():
+		case <-time.After(timeout):
+			cancel()
+		}
+	}()
+
+	return &gracefulContext{Context: detached, parent: ctx}, cancel
+}
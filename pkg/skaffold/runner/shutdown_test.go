@@ -0,0 +1,77 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+type contextKey string
+
+func TestWithGracefulShutdown(t *testing.T) {
+	testutil.Run(t, "no timeout", func(t *testutil.T) {
+		ctx := context.Background()
+
+		deployCtx, cancel := withGracefulShutdown(ctx, ioutil.Discard, 0)
+		defer cancel()
+
+		if deployCtx != ctx {
+			t.Error("the context should be returned as is")
+		}
+	})
+
+	testutil.Run(t, "deploy completes after the interruption", func(t *testutil.T) {
+		event.InitializeState(latest.BuildConfig{})
+		ctx, interrupt := context.WithCancel(context.WithValue(context.Background(), contextKey("key"), "value"))
+
+		deployCtx, cancel := withGracefulShutdown(ctx, ioutil.Discard, time.Minute)
+		interrupt()
+
+		select {
+		case <-deployCtx.Done():
+			t.Fatal("the deploy context shouldn't be cancelled before the timeout")
+		case <-time.After(50 * time.Millisecond):
+		}
+		t.CheckDeepEqual("value", deployCtx.Value(contextKey("key")))
+
+		cancel()
+		<-deployCtx.Done()
+	})
+
+	testutil.Run(t, "deploy aborted after the timeout", func(t *testutil.T) {
+		event.InitializeState(latest.BuildConfig{})
+		ctx, interrupt := context.WithCancel(context.Background())
+
+		deployCtx, cancel := withGracefulShutdown(ctx, ioutil.Discard, 10*time.Millisecond)
+		defer cancel()
+		interrupt()
+
+		select {
+		case <-deployCtx.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatal("the deploy context should be cancelled after the timeout")
+		}
+		t.CheckErrorContains(context.Canceled.Error(), deployCtx.Err())
+	})
+}
@@ -346,27 +346,62 @@ type TestCase struct {
 	// to run on that artifact.
 	// For example: `["./test/*"]`.
 	StructureTests []string `yaml:"structureTests,omitempty"`
+
+	// Commands lists shell commands to run, in order, to test that artifact.
+	// The reference of the built image is available in the `IMAGE` environment variable.
+	// A command that exits with a non-zero status fails the tests.
+	// For example: `["docker run --rm $IMAGE /smoke-test.sh"]`.
+	Commands []string `yaml:"commands,omitempty"`
 }
 
 // DeployConfig contains all the configuration needed by the deploy steps.
 type DeployConfig struct {
 	// StatusCheckDeadlineSeconds *beta* is the deadline for deployments to stabilize in seconds.
 	StatusCheckDeadlineSeconds int `yaml:"statusCheckDeadlineSeconds,omitempty"`
-	DeployType                 `yaml:",inline"`
+
+	// PreDeploy lists the commands to run, in order, before deploying.
+	// The images that were built are available as environment variables.
+	// A failing command aborts the deploy.
+	PreDeploy []string `yaml:"preDeploy,omitempty"`
+
+	// PostDeploy lists the commands to run, in order, after a successful deploy.
+	// The images that were built are available as environment variables.
+	// A failing command fails the deploy.
+	PostDeploy []string `yaml:"postDeploy,omitempty"`
+
+	// Dependencies lists ordering constraints between deployed resources.
+	// Resources are applied in tiers: a resource is only applied once
+	// all the resources it depends on are ready.
+	Dependencies []DeployDependency `yaml:"dependencies,omitempty"`
+
+	DeployType `yaml:",inline"`
+}
+
+// DeployDependency declares that a resource must wait for other resources
+// to be ready before being applied.
+type DeployDependency struct {
+	// Resource is the resource that waits, in the `kind/name` format.
+	// For example: `deployment/web`.
+	Resource string `yaml:"resource" yamltags:"required"`
+
+	// DependsOn lists the resources that must be ready first, in the `kind/name` format.
+	// For example: `["job/migrate", "statefulset/db"]`.
+	DependsOn []string `yaml:"dependsOn" yamltags:"required"`
 }
 
 // DeployType contains the specific implementation and parameters needed
-// for the deploy step. Only one field should be populated.
+// for the deploy step. Several deployers can be combined, in which case
+// they are run in the following order: helm, kubectl and kustomize.
 type DeployType struct {
 	// HelmDeploy *beta* uses the `helm` CLI to apply the charts to the cluster.
-	HelmDeploy *HelmDeploy `yaml:"helm,omitempty" yamltags:"oneOf=deploy"`
+	HelmDeploy *HelmDeploy `yaml:"helm,omitempty"`
 
 	// KubectlDeploy *beta* uses a client side `kubectl apply` to deploy manifests.
 	// You'll need a `kubectl` CLI version installed that's compatible with your cluster.
-	KubectlDeploy *KubectlDeploy `yaml:"kubectl,omitempty" yamltags:"oneOf=deploy"`
+	KubectlDeploy *KubectlDeploy `yaml:"kubectl,omitempty"`
 
 	// KustomizeDeploy *beta* uses the `kustomize` CLI to "patch" a deployment for a target environment.
-	KustomizeDeploy *KustomizeDeploy `yaml:"kustomize,omitempty" yamltags:"oneOf=deploy"`
+	KustomizeDeploy *KustomizeDeploy `yaml:"kustomize,omitempty"`
 }
 
 // KubectlDeploy *beta* uses a client side `kubectl apply` to deploy manifests.
@@ -381,6 +416,12 @@ type KubectlDeploy struct {
 
 	// Flags are additional flags passed to `kubectl`.
 	Flags KubectlFlags `yaml:"flags,omitempty"`
+
+	// ServerSideApply applies the manifests with `kubectl apply --server-side`,
+	// so that fields owned by other field managers, such as operators, are respected.
+	// Conflicts fail the deploy unless `--force-conflicts` is used.
+	// Defaults to `false`.
+	ServerSideApply bool `yaml:"serverSideApply,omitempty"`
 }
 
 // KubectlFlags are additional flags passed on the command
@@ -426,6 +467,12 @@ type KustomizeDeploy struct {
 	// Defaults to `.`.
 	KustomizePath string `yaml:"path,omitempty"`
 
+	// Overlays maps profile names to the paths of the Kustomization files
+	// to use instead of `path` when these profiles are activated with `-p`.
+	// If several of them are activated, the last one wins.
+	// For example: `{"prod": "overlays/prod"}`.
+	Overlays map[string]string `yaml:"overlays,omitempty"`
+
 	// Flags are additional flags passed to `kubectl`.
 	Flags KubectlFlags `yaml:"flags,omitempty"`
 
@@ -259,6 +259,15 @@ func overlayOneOfField(config interface{}, profile interface{}) interface{} {
 	return config
 }
 
+// deployers can be combined, but a profile that sets any deployer
+// replaces the whole set of deployers from the original config.
+func overlayDeployType(config latest.DeployType, profile latest.DeployType) latest.DeployType {
+	if profile.HelmDeploy == nil && profile.KubectlDeploy == nil && profile.KustomizeDeploy == nil {
+		return config
+	}
+	return profile
+}
+
 func overlayStructField(config interface{}, profile interface{}) interface{} {
 	// we already know the top level fields for whatever struct we have are themselves structs
 	// (and not one-of values), so we need to recursively overlay them
@@ -281,6 +290,9 @@ func overlayProfileField(fieldName string, config interface{}, profile interface
 	logrus.Debugf("overlaying profile on config for field %s", fieldName)
 	switch v.Kind() {
 	case reflect.Struct:
+		if deployType, ok := profile.(latest.DeployType); ok {
+			return overlayDeployType(config.(latest.DeployType), deployType)
+		}
 		// check the first field of the struct for a oneOf yamltag.
 		if util.IsOneOfField(t.Field(0)) {
 			return overlayOneOfField(config, profile)
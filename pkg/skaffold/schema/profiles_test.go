@@ -233,6 +233,32 @@ func TestApplyProfiles(t *testing.T) {
 				withHelmDeploy(),
 			),
 		},
+		{
+			description: "profile without deployer keeps combined deployers",
+			profile:     "profile",
+			config: config(
+				withLocalBuild(
+					withGitTagger(),
+				),
+				withKubectlDeploy("k8s/*.yaml"),
+				withHelmDeploy(),
+				withProfiles(latest.Profile{
+					Name: "profile",
+					Pipeline: latest.Pipeline{
+						Build: latest.BuildConfig{
+							TagPolicy: latest.TagPolicy{ShaTagger: &latest.ShaTagger{}},
+						},
+					},
+				}),
+			),
+			expected: config(
+				withLocalBuild(
+					withShaTagger(),
+				),
+				withKubectlDeploy("k8s/*.yaml"),
+				withHelmDeploy(),
+			),
+		},
 		{
 			description: "patch Dockerfile",
 			profile:     "profile",
@@ -18,9 +18,12 @@ package validation
 
 import (
 	"fmt"
+	"os"
 	"reflect"
+	"sort"
 	"strings"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/yamltags"
@@ -39,6 +42,8 @@ func Process(config *latest.SkaffoldConfig) error {
 	errs = append(errs, validateCustomDependencies(config.Build.Artifacts)...)
 	errs = append(errs, validateSyncRules(config.Build.Artifacts)...)
 	errs = append(errs, validatePortForwardResources(config.PortForward)...)
+	errs = append(errs, validateKustomizeOverlays(config.Deploy.KustomizeDeploy)...)
+	errs = append(errs, validateDeployDependencies(config.Deploy.Dependencies)...)
 
 	if len(errs) == 0 {
 		return nil
@@ -187,3 +192,43 @@ func validatePortForwardResources(pfrs []*latest.PortForwardResource) []error {
 	}
 	return errs
 }
+
+// validateKustomizeOverlays makes sure that the overlays selected by profile are existing directories.
+func validateKustomizeOverlays(kustomizeDeploy *latest.KustomizeDeploy) []error {
+	if kustomizeDeploy == nil {
+		return nil
+	}
+
+	var profiles []string
+	for profile := range kustomizeDeploy.Overlays {
+		profiles = append(profiles, profile)
+	}
+	sort.Strings(profiles)
+
+	var errs []error
+	for _, profile := range profiles {
+		path := kustomizeDeploy.Overlays[profile]
+		if info, err := os.Stat(path); err != nil || !info.IsDir() {
+			errs = append(errs, fmt.Errorf("kustomize overlay %q of profile %s is not a directory", path, profile))
+		}
+	}
+	return errs
+}
+
+// validateDeployDependencies makes sure that the deploy dependencies use the `kind/name`
+// format and don't form a cycle.
+func validateDeployDependencies(deps []latest.DeployDependency) []error {
+	var errs []error
+	for _, dep := range deps {
+		for _, resource := range append([]string{dep.Resource}, dep.DependsOn...) {
+			if parts := strings.Split(resource, "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				errs = append(errs, fmt.Errorf("invalid deploy dependency %q: expected the kind/name format", resource))
+			}
+		}
+	}
+
+	if err := kubectl.CheckDependencyCycles(deps); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
@@ -553,6 +553,82 @@ func TestValidatePortForwardResources(t *testing.T) {
 	}
 }
 
+func TestValidateKustomizeOverlays(t *testing.T) {
+	tests := []struct {
+		description string
+		overlays    map[string]string
+		shouldErr   bool
+	}{
+		{
+			description: "no overlays",
+		},
+		{
+			description: "existing overlays",
+			overlays:    map[string]string{"dev": "overlays/dev", "prod": "overlays/prod"},
+		},
+		{
+			description: "missing overlay",
+			overlays:    map[string]string{"dev": "overlays/dev", "staging": "overlays/staging"},
+			shouldErr:   true,
+		},
+		{
+			description: "overlay is a file",
+			overlays:    map[string]string{"prod": "overlays/prod/kustomization.yaml"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().
+				Touch("overlays/dev/kustomization.yaml", "overlays/prod/kustomization.yaml").
+				Chdir()
+
+			errs := validateKustomizeOverlays(&latest.KustomizeDeploy{Overlays: test.overlays})
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
+func TestValidateDeployDependencies(t *testing.T) {
+	tests := []struct {
+		description string
+		deps        []latest.DeployDependency
+		shouldErr   bool
+	}{
+		{
+			description: "no dependencies",
+		},
+		{
+			description: "valid dependencies",
+			deps: []latest.DeployDependency{
+				{Resource: "deployment/web", DependsOn: []string{"job/migrate", "statefulset/db"}},
+				{Resource: "job/migrate", DependsOn: []string{"statefulset/db"}},
+			},
+		},
+		{
+			description: "invalid format",
+			deps:        []latest.DeployDependency{{Resource: "web", DependsOn: []string{"job/migrate"}}},
+			shouldErr:   true,
+		},
+		{
+			description: "cycle",
+			deps: []latest.DeployDependency{
+				{Resource: "deployment/web", DependsOn: []string{"job/migrate"}},
+				{Resource: "job/migrate", DependsOn: []string{"deployment/web"}},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			errs := validateDeployDependencies(test.deps)
+
+			t.CheckDeepEqual(test.shouldErr, len(errs) > 0)
+		})
+	}
+}
+
 func TestValidateImageNames(t *testing.T) {
 	tests := []struct {
 		description string
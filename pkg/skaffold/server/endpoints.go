@@ -28,6 +28,10 @@ func (s *server) GetState(context.Context, *empty.Empty) (*proto.State, error) {
 	return event.GetState()
 }
 
+func (s *server) StateChanges(_ *empty.Empty, stream proto.SkaffoldService_StateChangesServer) error {
+	return event.ForEachStateChange(stream.Send)
+}
+
 func (s *server) EventLog(stream proto.SkaffoldService_EventLogServer) error {
 	return event.ForEachEvent(stream.Send)
 }
@@ -50,7 +54,7 @@ func (s *server) Execute(ctx context.Context, intent *proto.UserIntentRequest) (
 	}
 
 	if intent.GetIntent().GetDeploy() {
-		event.ResetStateOnDeploy()
+		event.ResetStateOnRedeploy()
 		go func() {
 			s.deployIntentCallback()
 		}()
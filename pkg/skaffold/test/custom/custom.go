@@ -0,0 +1,105 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package custom
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// Test runs the command with the reference of the image to test in the `IMAGE`
+// environment variable. The test fails if the command exits with a non-zero status.
+func (tr *Runner) Test(ctx context.Context, out io.Writer, image string) error {
+	logrus.Infof("Running test command %q on image %s", tr.command, image)
+	event.TestCommandInProgress(tr.imageName, tr.command)
+
+	output := &lineWriter{
+		out: out,
+		onLine: func(line string) {
+			event.TestCommandOutput(tr.imageName, tr.command, line)
+		},
+	}
+
+	cmd := shellCommand(ctx, tr.command)
+	cmd.Dir = tr.workingDir
+	cmd.Env = tr.env(image)
+	cmd.Stdout = output
+	cmd.Stderr = output
+
+	err := util.RunCmd(cmd)
+	output.flush()
+	if err != nil {
+		event.TestCommandFailed(tr.imageName, tr.command, err)
+		return errors.Wrapf(err, "running test command %q", tr.command)
+	}
+
+	event.TestCommandComplete(tr.imageName, tr.command)
+	return nil
+}
+
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
+
+// env exposes the image to test, on top of the current process environment
+// and of the extra docker environment, for example when running on minikube.
+func (tr *Runner) env(image string) []string {
+	env := append(util.OSEnviron(), tr.extraEnv...)
+	return append(env, fmt.Sprintf("IMAGE=%s", image))
+}
+
+// lineWriter copies what a command prints and reports each complete line.
+type lineWriter struct {
+	out    io.Writer
+	onLine func(string)
+	buf    bytes.Buffer
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for {
+		i := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+		line := w.buf.Next(i + 1)
+		w.onLine(string(bytes.TrimRight(line, "\r\n")))
+	}
+
+	return w.out.Write(p)
+}
+
+// flush reports the last line, if it isn't terminated by a newline.
+func (w *lineWriter) flush() {
+	if w.buf.Len() > 0 {
+		w.onLine(w.buf.String())
+		w.buf.Reset()
+	}
+}
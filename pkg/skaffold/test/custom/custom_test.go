@@ -0,0 +1,83 @@
+/*
+Copyright 2019 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package custom
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestTest(t *testing.T) {
+	tests := []struct {
+		description string
+		command     *testutil.FakeCmd
+		shouldErr   bool
+	}{
+		{
+			description: "success",
+			command:     testutil.CmdRun("sh -c ./smoke-test.sh"),
+		},
+		{
+			description: "failure",
+			command:     testutil.CmdRunErr("sh -c ./smoke-test.sh", errors.New("exit status 1")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.command)
+
+			err := NewRunner("image", "./smoke-test.sh", ".", nil).Test(context.Background(), &bytes.Buffer{}, "image:tag")
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+func TestEnv(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.OSEnviron, func() []string { return []string{"PATH=/bin"} })
+
+		env := NewRunner("image", "./smoke-test.sh", ".", []string{"DOCKER_HOST=tcp://minikube:2376"}).env("image:tag")
+
+		t.CheckDeepEqual([]string{"PATH=/bin", "DOCKER_HOST=tcp://minikube:2376", "IMAGE=image:tag"}, env)
+	})
+}
+
+func TestLineWriter(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var out bytes.Buffer
+		var lines []string
+		w := &lineWriter{
+			out:    &out,
+			onLine: func(line string) { lines = append(lines, line) },
+		}
+
+		w.Write([]byte("first line\nsecond"))
+		w.Write([]byte(" line\r\nlast"))
+		t.CheckDeepEqual([]string{"first line", "second line"}, lines)
+
+		w.flush()
+		t.CheckDeepEqual([]string{"first line", "second line", "last"}, lines)
+		t.CheckDeepEqual("first line\nsecond line\r\nlast", out.String())
+	})
+}
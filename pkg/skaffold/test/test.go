@@ -24,8 +24,10 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner/runcontext"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/custom"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test/structure"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
@@ -66,9 +68,16 @@ func (t FullTester) TestDependencies() ([]string, error) {
 // entrypoint to all individual tests.
 func (t FullTester) Test(ctx context.Context, out io.Writer, bRes []build.Artifact) error {
 	for _, test := range t.testCases {
+		event.TestInProgress(test.ImageName)
 		if err := t.runStructureTests(ctx, out, bRes, test); err != nil {
+			event.TestFailed(test.ImageName, err)
 			return errors.Wrap(err, "running structure tests")
 		}
+		if err := t.runCommandTests(ctx, out, bRes, test); err != nil {
+			event.TestFailed(test.ImageName, err)
+			return errors.Wrap(err, "running test commands")
+		}
+		event.TestComplete(test.ImageName)
 	}
 
 	return nil
@@ -90,6 +99,19 @@ func (t FullTester) runStructureTests(ctx context.Context, out io.Writer, bRes [
 	return runner.Test(ctx, out, fqn)
 }
 
+func (t FullTester) runCommandTests(ctx context.Context, out io.Writer, bRes []build.Artifact, testCase *latest.TestCase) error {
+	fqn := resolveArtifactImageTag(testCase.ImageName, bRes)
+
+	for _, command := range testCase.Commands {
+		runner := custom.NewRunner(testCase.ImageName, command, t.workingDir, t.extraEnv)
+		if err := runner.Test(ctx, out, fqn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resolveArtifactImageTag(imageName string, bRes []build.Artifact) string {
 	for _, res := range bRes {
 		if imageName == res.ImageName {
@@ -146,3 +146,44 @@ func TestTestFailure(t *testing.T) {
 		t.CheckError(true, err)
 	})
 }
+
+func TestTestCommands(t *testing.T) {
+	tests := []struct {
+		description string
+		commands    *testutil.FakeCmd
+		shouldErr   bool
+	}{
+		{
+			description: "all commands succeed",
+			commands: testutil.
+				CmdRun("sh -c curl-test.sh").
+				AndRun("sh -c docker run --rm $IMAGE /smoke-test.sh"),
+		},
+		{
+			description: "first command fails",
+			commands:    testutil.CmdRunErr("sh -c curl-test.sh", errors.New("FAIL")),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+
+			runCtx := &runcontext.RunContext{
+				Cfg: latest.Pipeline{
+					Test: []*latest.TestCase{{
+						ImageName: "image",
+						Commands:  []string{"curl-test.sh", "docker run --rm $IMAGE /smoke-test.sh"},
+					}},
+				},
+			}
+
+			err := NewTester(runCtx).Test(context.Background(), ioutil.Discard, []build.Artifact{{
+				ImageName: "image",
+				Tag:       "TAG",
+			}})
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -149,6 +150,9 @@ type State struct {
 	DeployState          *DeployState         `protobuf:"bytes,2,opt,name=deployState,proto3" json:"deployState,omitempty"`
 	ForwardedPorts       map[int32]*PortEvent `protobuf:"bytes,4,rep,name=forwardedPorts,proto3" json:"forwardedPorts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	StatusCheckState     *StatusCheckState    `protobuf:"bytes,5,opt,name=statusCheckState,proto3" json:"statusCheckState,omitempty"`
+	TestState            *TestState           `protobuf:"bytes,6,opt,name=testState,proto3" json:"testState,omitempty"`
+	Warnings             []*WarningEvent      `protobuf:"bytes,7,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	RunStatus            string               `protobuf:"bytes,8,opt,name=runStatus,proto3" json:"runStatus,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -207,20 +211,104 @@ func (m *State) GetStatusCheckState() *StatusCheckState {
 	return nil
 }
 
+func (m *State) GetTestState() *TestState {
+	if m != nil {
+		return m.TestState
+	}
+	return nil
+}
+
+func (m *State) GetWarnings() []*WarningEvent {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+func (m *State) GetRunStatus() string {
+	if m != nil {
+		return m.RunStatus
+	}
+	return ""
+}
+
+// StateChangedEvent describes a change of the state. Only the sub-states that
+// changed are set and their names are listed, so that a sub-state that was
+// emptied can be told apart from one that didn't change.
+type StateChangedEvent struct {
+	Revision             int64    `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	State                *State   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Changed              []string `protobuf:"bytes,3,rep,name=changed,proto3" json:"changed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateChangedEvent) Reset()         { *m = StateChangedEvent{} }
+func (m *StateChangedEvent) String() string { return proto.CompactTextString(m) }
+func (*StateChangedEvent) ProtoMessage()    {}
+func (*StateChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{4}
+}
+
+func (m *StateChangedEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateChangedEvent.Unmarshal(m, b)
+}
+func (m *StateChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateChangedEvent.Marshal(b, m, deterministic)
+}
+func (m *StateChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateChangedEvent.Merge(m, src)
+}
+func (m *StateChangedEvent) XXX_Size() int {
+	return xxx_messageInfo_StateChangedEvent.Size(m)
+}
+func (m *StateChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateChangedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateChangedEvent proto.InternalMessageInfo
+
+func (m *StateChangedEvent) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *StateChangedEvent) GetState() *State {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *StateChangedEvent) GetChanged() []string {
+	if m != nil {
+		return m.Changed
+	}
+	return nil
+}
+
 // BuildState contains a map of all skaffold artifacts to their current build
-// states
+// states, and to the duration of their last completed build
 type BuildState struct {
-	Artifacts            map[string]string `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Artifacts map[string]string             `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Durations map[string]*duration.Duration `protobuf:"bytes,2,rep,name=durations,proto3" json:"durations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// images maps each built artifact to its fully qualified image reference.
+	Images map[string]string `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// cached tells which artifacts were found in the cache instead of being built.
+	Cached               map[string]bool `protobuf:"bytes,4,rep,name=cached,proto3" json:"cached,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *BuildState) Reset()         { *m = BuildState{} }
 func (m *BuildState) String() string { return proto.CompactTextString(m) }
 func (*BuildState) ProtoMessage()    {}
 func (*BuildState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{4}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{5}
 }
 
 func (m *BuildState) XXX_Unmarshal(b []byte) error {
@@ -248,19 +336,85 @@ func (m *BuildState) GetArtifacts() map[string]string {
 	return nil
 }
 
-// DeployState contains the status of the current deploy
+func (m *BuildState) GetDurations() map[string]*duration.Duration {
+	if m != nil {
+		return m.Durations
+	}
+	return nil
+}
+
+func (m *BuildState) GetImages() map[string]string {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+func (m *BuildState) GetCached() map[string]bool {
+	if m != nil {
+		return m.Cached
+	}
+	return nil
+}
+
+// TestState contains a map of all skaffold artifacts to their current test
+// states
+type TestState struct {
+	Artifacts            map[string]string `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TestState) Reset()         { *m = TestState{} }
+func (m *TestState) String() string { return proto.CompactTextString(m) }
+func (*TestState) ProtoMessage()    {}
+func (*TestState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{6}
+}
+
+func (m *TestState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestState.Unmarshal(m, b)
+}
+func (m *TestState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestState.Marshal(b, m, deterministic)
+}
+func (m *TestState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestState.Merge(m, src)
+}
+func (m *TestState) XXX_Size() int {
+	return xxx_messageInfo_TestState.Size(m)
+}
+func (m *TestState) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestState proto.InternalMessageInfo
+
+func (m *TestState) GetArtifacts() map[string]string {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+// DeployState contains the status of the current deploy, and the status
+// of its rollback when one was attempted
 type DeployState struct {
-	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Status               string            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RollbackStatus       string            `protobuf:"bytes,2,opt,name=rollbackStatus,proto3" json:"rollbackStatus,omitempty"`
+	KubeContexts         map[string]string `protobuf:"bytes,3,rep,name=kubeContexts,proto3" json:"kubeContexts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Timings              *DeployTimings    `protobuf:"bytes,4,opt,name=timings,proto3" json:"timings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *DeployState) Reset()         { *m = DeployState{} }
 func (m *DeployState) String() string { return proto.CompactTextString(m) }
 func (*DeployState) ProtoMessage()    {}
 func (*DeployState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{5}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{7}
 }
 
 func (m *DeployState) XXX_Unmarshal(b []byte) error {
@@ -288,20 +442,101 @@ func (m *DeployState) GetStatus() string {
 	return ""
 }
 
+func (m *DeployState) GetRollbackStatus() string {
+	if m != nil {
+		return m.RollbackStatus
+	}
+	return ""
+}
+
+func (m *DeployState) GetKubeContexts() map[string]string {
+	if m != nil {
+		return m.KubeContexts
+	}
+	return nil
+}
+
+func (m *DeployState) GetTimings() *DeployTimings {
+	if m != nil {
+		return m.Timings
+	}
+	return nil
+}
+
+// DeployTimings contains the time spent in each phase of the deploy.
+type DeployTimings struct {
+	Render               *duration.Duration `protobuf:"bytes,1,opt,name=render,proto3" json:"render,omitempty"`
+	Apply                *duration.Duration `protobuf:"bytes,2,opt,name=apply,proto3" json:"apply,omitempty"`
+	StatusCheck          *duration.Duration `protobuf:"bytes,3,opt,name=statusCheck,proto3" json:"statusCheck,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DeployTimings) Reset()         { *m = DeployTimings{} }
+func (m *DeployTimings) String() string { return proto.CompactTextString(m) }
+func (*DeployTimings) ProtoMessage()    {}
+func (*DeployTimings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{8}
+}
+
+func (m *DeployTimings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeployTimings.Unmarshal(m, b)
+}
+func (m *DeployTimings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeployTimings.Marshal(b, m, deterministic)
+}
+func (m *DeployTimings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployTimings.Merge(m, src)
+}
+func (m *DeployTimings) XXX_Size() int {
+	return xxx_messageInfo_DeployTimings.Size(m)
+}
+func (m *DeployTimings) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployTimings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployTimings proto.InternalMessageInfo
+
+func (m *DeployTimings) GetRender() *duration.Duration {
+	if m != nil {
+		return m.Render
+	}
+	return nil
+}
+
+func (m *DeployTimings) GetApply() *duration.Duration {
+	if m != nil {
+		return m.Apply
+	}
+	return nil
+}
+
+func (m *DeployTimings) GetStatusCheck() *duration.Duration {
+	if m != nil {
+		return m.StatusCheck
+	}
+	return nil
+}
+
 // StatusCheckState contains the state of status check of current deployed resources.
 type StatusCheckState struct {
-	Status               string            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Resources            map[string]string `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Status               string                   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Resources            map[string]string        `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Summary              *StatusCheckSummaryEvent `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	TotalResources       int32                    `protobuf:"varint,4,opt,name=totalResources,proto3" json:"totalResources,omitempty"`
+	ReadyResources       int32                    `protobuf:"varint,5,opt,name=readyResources,proto3" json:"readyResources,omitempty"`
+	Percentage           int32                    `protobuf:"varint,6,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *StatusCheckState) Reset()         { *m = StatusCheckState{} }
 func (m *StatusCheckState) String() string { return proto.CompactTextString(m) }
 func (*StatusCheckState) ProtoMessage()    {}
 func (*StatusCheckState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{6}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{9}
 }
 
 func (m *StatusCheckState) XXX_Unmarshal(b []byte) error {
@@ -336,6 +571,34 @@ func (m *StatusCheckState) GetResources() map[string]string {
 	return nil
 }
 
+func (m *StatusCheckState) GetSummary() *StatusCheckSummaryEvent {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+func (m *StatusCheckState) GetTotalResources() int32 {
+	if m != nil {
+		return m.TotalResources
+	}
+	return 0
+}
+
+func (m *StatusCheckState) GetReadyResources() int32 {
+	if m != nil {
+		return m.ReadyResources
+	}
+	return 0
+}
+
+func (m *StatusCheckState) GetPercentage() int32 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
 type Event struct {
 	// Types that are valid to be assigned to EventType:
 	//	*Event_MetaEvent
@@ -344,6 +607,17 @@ type Event struct {
 	//	*Event_PortEvent
 	//	*Event_StatusCheckEvent
 	//	*Event_ResourceStatusCheckEvent
+	//	*Event_TestEvent
+	//	*Event_DeployRollbackEvent
+	//	*Event_PortForwardTerminatedEvent
+	//	*Event_DeployHookEvent
+	//	*Event_WarningEvent
+	//	*Event_StatusCheckSummaryEvent
+	//	*Event_BuildCacheHitEvent
+	//	*Event_ImagePushProgressEvent
+	//	*Event_ApplicationLogEvent
+	//	*Event_FileChangedEvent
+	//	*Event_RunCompleteEvent
 	EventType            isEvent_EventType `protobuf_oneof:"event_type"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -354,7 +628,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{7}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{10}
 }
 
 func (m *Event) XXX_Unmarshal(b []byte) error {
@@ -403,6 +677,50 @@ type Event_ResourceStatusCheckEvent struct {
 	ResourceStatusCheckEvent *ResourceStatusCheckEvent `protobuf:"bytes,6,opt,name=resourceStatusCheckEvent,proto3,oneof"`
 }
 
+type Event_TestEvent struct {
+	TestEvent *TestEvent `protobuf:"bytes,7,opt,name=testEvent,proto3,oneof"`
+}
+
+type Event_DeployRollbackEvent struct {
+	DeployRollbackEvent *DeployRollbackEvent `protobuf:"bytes,8,opt,name=deployRollbackEvent,proto3,oneof"`
+}
+
+type Event_PortForwardTerminatedEvent struct {
+	PortForwardTerminatedEvent *PortForwardTerminatedEvent `protobuf:"bytes,9,opt,name=portForwardTerminatedEvent,proto3,oneof"`
+}
+
+type Event_DeployHookEvent struct {
+	DeployHookEvent *DeployHookEvent `protobuf:"bytes,10,opt,name=deployHookEvent,proto3,oneof"`
+}
+
+type Event_WarningEvent struct {
+	WarningEvent *WarningEvent `protobuf:"bytes,11,opt,name=warningEvent,proto3,oneof"`
+}
+
+type Event_StatusCheckSummaryEvent struct {
+	StatusCheckSummaryEvent *StatusCheckSummaryEvent `protobuf:"bytes,12,opt,name=statusCheckSummaryEvent,proto3,oneof"`
+}
+
+type Event_BuildCacheHitEvent struct {
+	BuildCacheHitEvent *BuildCacheHitEvent `protobuf:"bytes,13,opt,name=buildCacheHitEvent,proto3,oneof"`
+}
+
+type Event_ImagePushProgressEvent struct {
+	ImagePushProgressEvent *ImagePushProgressEvent `protobuf:"bytes,14,opt,name=imagePushProgressEvent,proto3,oneof"`
+}
+
+type Event_ApplicationLogEvent struct {
+	ApplicationLogEvent *ApplicationLogEvent `protobuf:"bytes,15,opt,name=applicationLogEvent,proto3,oneof"`
+}
+
+type Event_FileChangedEvent struct {
+	FileChangedEvent *FileChangedEvent `protobuf:"bytes,16,opt,name=fileChangedEvent,proto3,oneof"`
+}
+
+type Event_RunCompleteEvent struct {
+	RunCompleteEvent *RunCompleteEvent `protobuf:"bytes,17,opt,name=runCompleteEvent,proto3,oneof"`
+}
+
 func (*Event_MetaEvent) isEvent_EventType() {}
 
 func (*Event_BuildEvent) isEvent_EventType() {}
@@ -415,6 +733,28 @@ func (*Event_StatusCheckEvent) isEvent_EventType() {}
 
 func (*Event_ResourceStatusCheckEvent) isEvent_EventType() {}
 
+func (*Event_TestEvent) isEvent_EventType() {}
+
+func (*Event_DeployRollbackEvent) isEvent_EventType() {}
+
+func (*Event_PortForwardTerminatedEvent) isEvent_EventType() {}
+
+func (*Event_DeployHookEvent) isEvent_EventType() {}
+
+func (*Event_WarningEvent) isEvent_EventType() {}
+
+func (*Event_StatusCheckSummaryEvent) isEvent_EventType() {}
+
+func (*Event_BuildCacheHitEvent) isEvent_EventType() {}
+
+func (*Event_ImagePushProgressEvent) isEvent_EventType() {}
+
+func (*Event_ApplicationLogEvent) isEvent_EventType() {}
+
+func (*Event_FileChangedEvent) isEvent_EventType() {}
+
+func (*Event_RunCompleteEvent) isEvent_EventType() {}
+
 func (m *Event) GetEventType() isEvent_EventType {
 	if m != nil {
 		return m.EventType
@@ -464,6 +804,83 @@ func (m *Event) GetResourceStatusCheckEvent() *ResourceStatusCheckEvent {
 	return nil
 }
 
+func (m *Event) GetTestEvent() *TestEvent {
+	if x, ok := m.GetEventType().(*Event_TestEvent); ok {
+		return x.TestEvent
+	}
+	return nil
+}
+
+func (m *Event) GetDeployRollbackEvent() *DeployRollbackEvent {
+	if x, ok := m.GetEventType().(*Event_DeployRollbackEvent); ok {
+		return x.DeployRollbackEvent
+	}
+	return nil
+}
+
+func (m *Event) GetPortForwardTerminatedEvent() *PortForwardTerminatedEvent {
+	if x, ok := m.GetEventType().(*Event_PortForwardTerminatedEvent); ok {
+		return x.PortForwardTerminatedEvent
+	}
+	return nil
+}
+
+func (m *Event) GetDeployHookEvent() *DeployHookEvent {
+	if x, ok := m.GetEventType().(*Event_DeployHookEvent); ok {
+		return x.DeployHookEvent
+	}
+	return nil
+}
+
+func (m *Event) GetWarningEvent() *WarningEvent {
+	if x, ok := m.GetEventType().(*Event_WarningEvent); ok {
+		return x.WarningEvent
+	}
+	return nil
+}
+
+func (m *Event) GetStatusCheckSummaryEvent() *StatusCheckSummaryEvent {
+	if x, ok := m.GetEventType().(*Event_StatusCheckSummaryEvent); ok {
+		return x.StatusCheckSummaryEvent
+	}
+	return nil
+}
+
+func (m *Event) GetBuildCacheHitEvent() *BuildCacheHitEvent {
+	if x, ok := m.GetEventType().(*Event_BuildCacheHitEvent); ok {
+		return x.BuildCacheHitEvent
+	}
+	return nil
+}
+
+func (m *Event) GetImagePushProgressEvent() *ImagePushProgressEvent {
+	if x, ok := m.GetEventType().(*Event_ImagePushProgressEvent); ok {
+		return x.ImagePushProgressEvent
+	}
+	return nil
+}
+
+func (m *Event) GetApplicationLogEvent() *ApplicationLogEvent {
+	if x, ok := m.GetEventType().(*Event_ApplicationLogEvent); ok {
+		return x.ApplicationLogEvent
+	}
+	return nil
+}
+
+func (m *Event) GetFileChangedEvent() *FileChangedEvent {
+	if x, ok := m.GetEventType().(*Event_FileChangedEvent); ok {
+		return x.FileChangedEvent
+	}
+	return nil
+}
+
+func (m *Event) GetRunCompleteEvent() *RunCompleteEvent {
+	if x, ok := m.GetEventType().(*Event_RunCompleteEvent); ok {
+		return x.RunCompleteEvent
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -473,144 +890,905 @@ func (*Event) XXX_OneofWrappers() []interface{} {
 		(*Event_PortEvent)(nil),
 		(*Event_StatusCheckEvent)(nil),
 		(*Event_ResourceStatusCheckEvent)(nil),
+		(*Event_TestEvent)(nil),
+		(*Event_DeployRollbackEvent)(nil),
+		(*Event_PortForwardTerminatedEvent)(nil),
+		(*Event_DeployHookEvent)(nil),
+		(*Event_WarningEvent)(nil),
+		(*Event_StatusCheckSummaryEvent)(nil),
+		(*Event_BuildCacheHitEvent)(nil),
+		(*Event_ImagePushProgressEvent)(nil),
+		(*Event_ApplicationLogEvent)(nil),
+		(*Event_FileChangedEvent)(nil),
+		(*Event_RunCompleteEvent)(nil),
+	}
+}
+
+type MetaEvent struct {
+	Entry                string   `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MetaEvent) Reset()         { *m = MetaEvent{} }
+func (m *MetaEvent) String() string { return proto.CompactTextString(m) }
+func (*MetaEvent) ProtoMessage()    {}
+func (*MetaEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{11}
+}
+
+func (m *MetaEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetaEvent.Unmarshal(m, b)
+}
+func (m *MetaEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetaEvent.Marshal(b, m, deterministic)
+}
+func (m *MetaEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetaEvent.Merge(m, src)
+}
+func (m *MetaEvent) XXX_Size() int {
+	return xxx_messageInfo_MetaEvent.Size(m)
+}
+func (m *MetaEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetaEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetaEvent proto.InternalMessageInfo
+
+func (m *MetaEvent) GetEntry() string {
+	if m != nil {
+		return m.Entry
+	}
+	return ""
+}
+
+type BuildEvent struct {
+	Artifact             string             `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Status               string             `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Err                  string             `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	Duration             *duration.Duration `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Image                string             `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *BuildEvent) Reset()         { *m = BuildEvent{} }
+func (m *BuildEvent) String() string { return proto.CompactTextString(m) }
+func (*BuildEvent) ProtoMessage()    {}
+func (*BuildEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{12}
+}
+
+func (m *BuildEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildEvent.Unmarshal(m, b)
+}
+func (m *BuildEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildEvent.Marshal(b, m, deterministic)
+}
+func (m *BuildEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildEvent.Merge(m, src)
+}
+func (m *BuildEvent) XXX_Size() int {
+	return xxx_messageInfo_BuildEvent.Size(m)
+}
+func (m *BuildEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildEvent proto.InternalMessageInfo
+
+func (m *BuildEvent) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+func (m *BuildEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *BuildEvent) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *BuildEvent) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *BuildEvent) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+// BuildCacheHitEvent describes an artifact that didn't need to be built
+// because it was found in the cache.
+type BuildCacheHitEvent struct {
+	Artifact             string   `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Image                string   `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildCacheHitEvent) Reset()         { *m = BuildCacheHitEvent{} }
+func (m *BuildCacheHitEvent) String() string { return proto.CompactTextString(m) }
+func (*BuildCacheHitEvent) ProtoMessage()    {}
+func (*BuildCacheHitEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{13}
+}
+
+func (m *BuildCacheHitEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildCacheHitEvent.Unmarshal(m, b)
+}
+func (m *BuildCacheHitEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildCacheHitEvent.Marshal(b, m, deterministic)
+}
+func (m *BuildCacheHitEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildCacheHitEvent.Merge(m, src)
+}
+func (m *BuildCacheHitEvent) XXX_Size() int {
+	return xxx_messageInfo_BuildCacheHitEvent.Size(m)
+}
+func (m *BuildCacheHitEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildCacheHitEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildCacheHitEvent proto.InternalMessageInfo
+
+func (m *BuildCacheHitEvent) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+func (m *BuildCacheHitEvent) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+// ImagePushProgressEvent describes the progress of a layer being pushed to a registry.
+// Updates are coalesced so that only a few events are sent per second for each layer.
+type ImagePushProgressEvent struct {
+	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Layer                string   `protobuf:"bytes,2,opt,name=layer,proto3" json:"layer,omitempty"`
+	BytesPushed          int64    `protobuf:"varint,3,opt,name=bytesPushed,proto3" json:"bytesPushed,omitempty"`
+	BytesTotal           int64    `protobuf:"varint,4,opt,name=bytesTotal,proto3" json:"bytesTotal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImagePushProgressEvent) Reset()         { *m = ImagePushProgressEvent{} }
+func (m *ImagePushProgressEvent) String() string { return proto.CompactTextString(m) }
+func (*ImagePushProgressEvent) ProtoMessage()    {}
+func (*ImagePushProgressEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{14}
+}
+
+func (m *ImagePushProgressEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImagePushProgressEvent.Unmarshal(m, b)
+}
+func (m *ImagePushProgressEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImagePushProgressEvent.Marshal(b, m, deterministic)
+}
+func (m *ImagePushProgressEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImagePushProgressEvent.Merge(m, src)
+}
+func (m *ImagePushProgressEvent) XXX_Size() int {
+	return xxx_messageInfo_ImagePushProgressEvent.Size(m)
+}
+func (m *ImagePushProgressEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImagePushProgressEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImagePushProgressEvent proto.InternalMessageInfo
+
+func (m *ImagePushProgressEvent) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *ImagePushProgressEvent) GetLayer() string {
+	if m != nil {
+		return m.Layer
+	}
+	return ""
+}
+
+func (m *ImagePushProgressEvent) GetBytesPushed() int64 {
+	if m != nil {
+		return m.BytesPushed
+	}
+	return 0
+}
+
+func (m *ImagePushProgressEvent) GetBytesTotal() int64 {
+	if m != nil {
+		return m.BytesTotal
+	}
+	return 0
+}
+
+// ApplicationLogEvent describes a line logged by a container of a deployed pod.
+type ApplicationLogEvent struct {
+	PodName              string   `protobuf:"bytes,1,opt,name=podName,proto3" json:"podName,omitempty"`
+	ContainerName        string   `protobuf:"bytes,2,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationLogEvent) Reset()         { *m = ApplicationLogEvent{} }
+func (m *ApplicationLogEvent) String() string { return proto.CompactTextString(m) }
+func (*ApplicationLogEvent) ProtoMessage()    {}
+func (*ApplicationLogEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{15}
+}
+
+func (m *ApplicationLogEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationLogEvent.Unmarshal(m, b)
+}
+func (m *ApplicationLogEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationLogEvent.Marshal(b, m, deterministic)
+}
+func (m *ApplicationLogEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationLogEvent.Merge(m, src)
+}
+func (m *ApplicationLogEvent) XXX_Size() int {
+	return xxx_messageInfo_ApplicationLogEvent.Size(m)
+}
+func (m *ApplicationLogEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationLogEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationLogEvent proto.InternalMessageInfo
+
+func (m *ApplicationLogEvent) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *ApplicationLogEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// FileChangedEvent describes the file changes that triggered a new iteration
+// of the dev loop. Changes detected together are reported in a single event.
+type FileChangedEvent struct {
+	Paths                []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	Artifacts            []string `protobuf:"bytes,2,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileChangedEvent) Reset()         { *m = FileChangedEvent{} }
+func (m *FileChangedEvent) String() string { return proto.CompactTextString(m) }
+func (*FileChangedEvent) ProtoMessage()    {}
+func (*FileChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{16}
+}
+
+func (m *FileChangedEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileChangedEvent.Unmarshal(m, b)
+}
+func (m *FileChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileChangedEvent.Marshal(b, m, deterministic)
+}
+func (m *FileChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileChangedEvent.Merge(m, src)
+}
+func (m *FileChangedEvent) XXX_Size() int {
+	return xxx_messageInfo_FileChangedEvent.Size(m)
+}
+func (m *FileChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileChangedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileChangedEvent proto.InternalMessageInfo
+
+func (m *FileChangedEvent) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+func (m *FileChangedEvent) GetArtifacts() []string {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+// RunCompleteEvent is the last event of `skaffold run`. It tells whether the run
+// succeeded, which images were deployed and which resources were created or updated.
+type RunCompleteEvent struct {
+	Status               string              `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Err                  string              `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	Images               map[string]string   `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Resources            []*DeployedResource `protobuf:"bytes,4,rep,name=resources,proto3" json:"resources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *RunCompleteEvent) Reset()         { *m = RunCompleteEvent{} }
+func (m *RunCompleteEvent) String() string { return proto.CompactTextString(m) }
+func (*RunCompleteEvent) ProtoMessage()    {}
+func (*RunCompleteEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{17}
+}
+
+func (m *RunCompleteEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunCompleteEvent.Unmarshal(m, b)
+}
+func (m *RunCompleteEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunCompleteEvent.Marshal(b, m, deterministic)
+}
+func (m *RunCompleteEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunCompleteEvent.Merge(m, src)
+}
+func (m *RunCompleteEvent) XXX_Size() int {
+	return xxx_messageInfo_RunCompleteEvent.Size(m)
+}
+func (m *RunCompleteEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunCompleteEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunCompleteEvent proto.InternalMessageInfo
+
+func (m *RunCompleteEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *RunCompleteEvent) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *RunCompleteEvent) GetImages() map[string]string {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+func (m *RunCompleteEvent) GetResources() []*DeployedResource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// DeployedResource identifies a Kubernetes resource that was deployed.
+type DeployedResource struct {
+	ApiVersion           string   `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace            string   `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeployedResource) Reset()         { *m = DeployedResource{} }
+func (m *DeployedResource) String() string { return proto.CompactTextString(m) }
+func (*DeployedResource) ProtoMessage()    {}
+func (*DeployedResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{18}
+}
+
+func (m *DeployedResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeployedResource.Unmarshal(m, b)
+}
+func (m *DeployedResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeployedResource.Marshal(b, m, deterministic)
+}
+func (m *DeployedResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployedResource.Merge(m, src)
+}
+func (m *DeployedResource) XXX_Size() int {
+	return xxx_messageInfo_DeployedResource.Size(m)
+}
+func (m *DeployedResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployedResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployedResource proto.InternalMessageInfo
+
+func (m *DeployedResource) GetApiVersion() string {
+	if m != nil {
+		return m.ApiVersion
+	}
+	return ""
+}
+
+func (m *DeployedResource) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *DeployedResource) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DeployedResource) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type TestEvent struct {
+	Artifact             string   `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Err                  string   `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	Command              string   `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	Output               string   `protobuf:"bytes,5,opt,name=output,proto3" json:"output,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TestEvent) Reset()         { *m = TestEvent{} }
+func (m *TestEvent) String() string { return proto.CompactTextString(m) }
+func (*TestEvent) ProtoMessage()    {}
+func (*TestEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{19}
+}
+
+func (m *TestEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestEvent.Unmarshal(m, b)
+}
+func (m *TestEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestEvent.Marshal(b, m, deterministic)
+}
+func (m *TestEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestEvent.Merge(m, src)
+}
+func (m *TestEvent) XXX_Size() int {
+	return xxx_messageInfo_TestEvent.Size(m)
+}
+func (m *TestEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestEvent proto.InternalMessageInfo
+
+func (m *TestEvent) GetArtifact() string {
+	if m != nil {
+		return m.Artifact
+	}
+	return ""
+}
+
+func (m *TestEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TestEvent) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *TestEvent) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *TestEvent) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
+type DeployEvent struct {
+	Status               string         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Err                  string         `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	KubeContext          string         `protobuf:"bytes,3,opt,name=kubeContext,proto3" json:"kubeContext,omitempty"`
+	Attempt              int32          `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Timings              *DeployTimings `protobuf:"bytes,5,opt,name=timings,proto3" json:"timings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DeployEvent) Reset()         { *m = DeployEvent{} }
+func (m *DeployEvent) String() string { return proto.CompactTextString(m) }
+func (*DeployEvent) ProtoMessage()    {}
+func (*DeployEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{20}
+}
+
+func (m *DeployEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeployEvent.Unmarshal(m, b)
+}
+func (m *DeployEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeployEvent.Marshal(b, m, deterministic)
+}
+func (m *DeployEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployEvent.Merge(m, src)
+}
+func (m *DeployEvent) XXX_Size() int {
+	return xxx_messageInfo_DeployEvent.Size(m)
+}
+func (m *DeployEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployEvent proto.InternalMessageInfo
+
+func (m *DeployEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DeployEvent) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+func (m *DeployEvent) GetKubeContext() string {
+	if m != nil {
+		return m.KubeContext
+	}
+	return ""
+}
+
+func (m *DeployEvent) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *DeployEvent) GetTimings() *DeployTimings {
+	if m != nil {
+		return m.Timings
+	}
+	return nil
+}
+
+// DeployRollbackEvent describes the rollback of the resources
+// of a deploy that failed its status check
+type DeployRollbackEvent struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+	Resources            []string `protobuf:"bytes,3,rep,name=resources,proto3" json:"resources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeployRollbackEvent) Reset()         { *m = DeployRollbackEvent{} }
+func (m *DeployRollbackEvent) String() string { return proto.CompactTextString(m) }
+func (*DeployRollbackEvent) ProtoMessage()    {}
+func (*DeployRollbackEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{21}
+}
+
+func (m *DeployRollbackEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeployRollbackEvent.Unmarshal(m, b)
+}
+func (m *DeployRollbackEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeployRollbackEvent.Marshal(b, m, deterministic)
+}
+func (m *DeployRollbackEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployRollbackEvent.Merge(m, src)
+}
+func (m *DeployRollbackEvent) XXX_Size() int {
+	return xxx_messageInfo_DeployRollbackEvent.Size(m)
+}
+func (m *DeployRollbackEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployRollbackEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployRollbackEvent proto.InternalMessageInfo
+
+func (m *DeployRollbackEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DeployRollbackEvent) GetErr() string {
+	if m != nil {
+		return m.Err
 	}
+	return ""
 }
 
-type MetaEvent struct {
-	Entry                string   `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+func (m *DeployRollbackEvent) GetResources() []string {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// DeployHookEvent describes the execution of a command
+// that is run before or after a deploy
+type DeployHookEvent struct {
+	Phase                string   `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Command              string   `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Err                  string   `protobuf:"bytes,4,opt,name=err,proto3" json:"err,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MetaEvent) Reset()         { *m = MetaEvent{} }
-func (m *MetaEvent) String() string { return proto.CompactTextString(m) }
-func (*MetaEvent) ProtoMessage()    {}
-func (*MetaEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{8}
+func (m *DeployHookEvent) Reset()         { *m = DeployHookEvent{} }
+func (m *DeployHookEvent) String() string { return proto.CompactTextString(m) }
+func (*DeployHookEvent) ProtoMessage()    {}
+func (*DeployHookEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{22}
 }
 
-func (m *MetaEvent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MetaEvent.Unmarshal(m, b)
+func (m *DeployHookEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeployHookEvent.Unmarshal(m, b)
 }
-func (m *MetaEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MetaEvent.Marshal(b, m, deterministic)
+func (m *DeployHookEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeployHookEvent.Marshal(b, m, deterministic)
 }
-func (m *MetaEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MetaEvent.Merge(m, src)
+func (m *DeployHookEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployHookEvent.Merge(m, src)
 }
-func (m *MetaEvent) XXX_Size() int {
-	return xxx_messageInfo_MetaEvent.Size(m)
+func (m *DeployHookEvent) XXX_Size() int {
+	return xxx_messageInfo_DeployHookEvent.Size(m)
 }
-func (m *MetaEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_MetaEvent.DiscardUnknown(m)
+func (m *DeployHookEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployHookEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MetaEvent proto.InternalMessageInfo
+var xxx_messageInfo_DeployHookEvent proto.InternalMessageInfo
 
-func (m *MetaEvent) GetEntry() string {
+func (m *DeployHookEvent) GetPhase() string {
 	if m != nil {
-		return m.Entry
+		return m.Phase
 	}
 	return ""
 }
 
-type BuildEvent struct {
-	Artifact             string   `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
-	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Err                  string   `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+func (m *DeployHookEvent) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *DeployHookEvent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DeployHookEvent) GetErr() string {
+	if m != nil {
+		return m.Err
+	}
+	return ""
+}
+
+// WarningEvent describes a warning. The category groups similar
+// warnings together and the code identifies the exact warning.
+type WarningEvent struct {
+	Category             string   `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BuildEvent) Reset()         { *m = BuildEvent{} }
-func (m *BuildEvent) String() string { return proto.CompactTextString(m) }
-func (*BuildEvent) ProtoMessage()    {}
-func (*BuildEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{9}
+func (m *WarningEvent) Reset()         { *m = WarningEvent{} }
+func (m *WarningEvent) String() string { return proto.CompactTextString(m) }
+func (*WarningEvent) ProtoMessage()    {}
+func (*WarningEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{23}
 }
 
-func (m *BuildEvent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BuildEvent.Unmarshal(m, b)
+func (m *WarningEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WarningEvent.Unmarshal(m, b)
 }
-func (m *BuildEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BuildEvent.Marshal(b, m, deterministic)
+func (m *WarningEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WarningEvent.Marshal(b, m, deterministic)
 }
-func (m *BuildEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuildEvent.Merge(m, src)
+func (m *WarningEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WarningEvent.Merge(m, src)
 }
-func (m *BuildEvent) XXX_Size() int {
-	return xxx_messageInfo_BuildEvent.Size(m)
+func (m *WarningEvent) XXX_Size() int {
+	return xxx_messageInfo_WarningEvent.Size(m)
 }
-func (m *BuildEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_BuildEvent.DiscardUnknown(m)
+func (m *WarningEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WarningEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BuildEvent proto.InternalMessageInfo
+var xxx_messageInfo_WarningEvent proto.InternalMessageInfo
 
-func (m *BuildEvent) GetArtifact() string {
+func (m *WarningEvent) GetCategory() string {
 	if m != nil {
-		return m.Artifact
+		return m.Category
 	}
 	return ""
 }
 
-func (m *BuildEvent) GetStatus() string {
+func (m *WarningEvent) GetCode() string {
 	if m != nil {
-		return m.Status
+		return m.Code
 	}
 	return ""
 }
 
-func (m *BuildEvent) GetErr() string {
+func (m *WarningEvent) GetMessage() string {
 	if m != nil {
-		return m.Err
+		return m.Message
 	}
 	return ""
 }
 
-type DeployEvent struct {
-	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Err                  string   `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// StatusCheckSummaryEvent describes the outcome of a completed status check
+type StatusCheckSummaryEvent struct {
+	Resources            []*ResourceStatusCheckSummary `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	Duration             *duration.Duration            `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-func (m *DeployEvent) Reset()         { *m = DeployEvent{} }
-func (m *DeployEvent) String() string { return proto.CompactTextString(m) }
-func (*DeployEvent) ProtoMessage()    {}
-func (*DeployEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{10}
+func (m *StatusCheckSummaryEvent) Reset()         { *m = StatusCheckSummaryEvent{} }
+func (m *StatusCheckSummaryEvent) String() string { return proto.CompactTextString(m) }
+func (*StatusCheckSummaryEvent) ProtoMessage()    {}
+func (*StatusCheckSummaryEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{24}
 }
 
-func (m *DeployEvent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeployEvent.Unmarshal(m, b)
+func (m *StatusCheckSummaryEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusCheckSummaryEvent.Unmarshal(m, b)
 }
-func (m *DeployEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeployEvent.Marshal(b, m, deterministic)
+func (m *StatusCheckSummaryEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusCheckSummaryEvent.Marshal(b, m, deterministic)
 }
-func (m *DeployEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeployEvent.Merge(m, src)
+func (m *StatusCheckSummaryEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusCheckSummaryEvent.Merge(m, src)
 }
-func (m *DeployEvent) XXX_Size() int {
-	return xxx_messageInfo_DeployEvent.Size(m)
+func (m *StatusCheckSummaryEvent) XXX_Size() int {
+	return xxx_messageInfo_StatusCheckSummaryEvent.Size(m)
 }
-func (m *DeployEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeployEvent.DiscardUnknown(m)
+func (m *StatusCheckSummaryEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusCheckSummaryEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeployEvent proto.InternalMessageInfo
+var xxx_messageInfo_StatusCheckSummaryEvent proto.InternalMessageInfo
 
-func (m *DeployEvent) GetStatus() string {
+func (m *StatusCheckSummaryEvent) GetResources() []*ResourceStatusCheckSummary {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *StatusCheckSummaryEvent) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+// ResourceStatusCheckSummary describes the outcome of the status check of one resource
+type ResourceStatusCheckSummary struct {
+	Resource             string             `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Status               string             `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Duration             *duration.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Err                  string             `protobuf:"bytes,4,opt,name=err,proto3" json:"err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ResourceStatusCheckSummary) Reset()         { *m = ResourceStatusCheckSummary{} }
+func (m *ResourceStatusCheckSummary) String() string { return proto.CompactTextString(m) }
+func (*ResourceStatusCheckSummary) ProtoMessage()    {}
+func (*ResourceStatusCheckSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{25}
+}
+
+func (m *ResourceStatusCheckSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceStatusCheckSummary.Unmarshal(m, b)
+}
+func (m *ResourceStatusCheckSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceStatusCheckSummary.Marshal(b, m, deterministic)
+}
+func (m *ResourceStatusCheckSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceStatusCheckSummary.Merge(m, src)
+}
+func (m *ResourceStatusCheckSummary) XXX_Size() int {
+	return xxx_messageInfo_ResourceStatusCheckSummary.Size(m)
+}
+func (m *ResourceStatusCheckSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceStatusCheckSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceStatusCheckSummary proto.InternalMessageInfo
+
+func (m *ResourceStatusCheckSummary) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *ResourceStatusCheckSummary) GetStatus() string {
 	if m != nil {
 		return m.Status
 	}
 	return ""
 }
 
-func (m *DeployEvent) GetErr() string {
+func (m *ResourceStatusCheckSummary) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *ResourceStatusCheckSummary) GetErr() string {
 	if m != nil {
 		return m.Err
 	}
@@ -621,6 +1799,8 @@ type StatusCheckEvent struct {
 	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Err                  string   `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	TotalResources       int32    `protobuf:"varint,4,opt,name=totalResources,proto3" json:"totalResources,omitempty"`
+	ReadyResources       int32    `protobuf:"varint,5,opt,name=readyResources,proto3" json:"readyResources,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -630,7 +1810,7 @@ func (m *StatusCheckEvent) Reset()         { *m = StatusCheckEvent{} }
 func (m *StatusCheckEvent) String() string { return proto.CompactTextString(m) }
 func (*StatusCheckEvent) ProtoMessage()    {}
 func (*StatusCheckEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{11}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{26}
 }
 
 func (m *StatusCheckEvent) XXX_Unmarshal(b []byte) error {
@@ -672,6 +1852,20 @@ func (m *StatusCheckEvent) GetErr() string {
 	return ""
 }
 
+func (m *StatusCheckEvent) GetTotalResources() int32 {
+	if m != nil {
+		return m.TotalResources
+	}
+	return 0
+}
+
+func (m *StatusCheckEvent) GetReadyResources() int32 {
+	if m != nil {
+		return m.ReadyResources
+	}
+	return 0
+}
+
 type ResourceStatusCheckEvent struct {
 	Resource             string   `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
 	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -686,7 +1880,7 @@ func (m *ResourceStatusCheckEvent) Reset()         { *m = ResourceStatusCheckEve
 func (m *ResourceStatusCheckEvent) String() string { return proto.CompactTextString(m) }
 func (*ResourceStatusCheckEvent) ProtoMessage()    {}
 func (*ResourceStatusCheckEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{12}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{27}
 }
 
 func (m *ResourceStatusCheckEvent) XXX_Unmarshal(b []byte) error {
@@ -744,6 +1938,7 @@ type PortEvent struct {
 	PortName             string   `protobuf:"bytes,6,opt,name=portName,proto3" json:"portName,omitempty"`
 	ResourceType         string   `protobuf:"bytes,7,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
 	ResourceName         string   `protobuf:"bytes,8,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
+	Protocol             string   `protobuf:"bytes,9,opt,name=protocol,proto3" json:"protocol,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -753,7 +1948,7 @@ func (m *PortEvent) Reset()         { *m = PortEvent{} }
 func (m *PortEvent) String() string { return proto.CompactTextString(m) }
 func (*PortEvent) ProtoMessage()    {}
 func (*PortEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{13}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{28}
 }
 
 func (m *PortEvent) XXX_Unmarshal(b []byte) error {
@@ -830,20 +2025,111 @@ func (m *PortEvent) GetResourceName() string {
 	return ""
 }
 
+func (m *PortEvent) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+// PortForwardTerminatedEvent describes a port forward that was stopped
+// because its pod went away. The port is forwarded again when the pod is replaced.
+type PortForwardTerminatedEvent struct {
+	LocalPort            int32    `protobuf:"varint,1,opt,name=localPort,proto3" json:"localPort,omitempty"`
+	RemotePort           int32    `protobuf:"varint,2,opt,name=remotePort,proto3" json:"remotePort,omitempty"`
+	PodName              string   `protobuf:"bytes,3,opt,name=podName,proto3" json:"podName,omitempty"`
+	ContainerName        string   `protobuf:"bytes,4,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	Namespace            string   `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PortName             string   `protobuf:"bytes,6,opt,name=portName,proto3" json:"portName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortForwardTerminatedEvent) Reset()         { *m = PortForwardTerminatedEvent{} }
+func (m *PortForwardTerminatedEvent) String() string { return proto.CompactTextString(m) }
+func (*PortForwardTerminatedEvent) ProtoMessage()    {}
+func (*PortForwardTerminatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f2d38e344f9dbf5, []int{29}
+}
+
+func (m *PortForwardTerminatedEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortForwardTerminatedEvent.Unmarshal(m, b)
+}
+func (m *PortForwardTerminatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortForwardTerminatedEvent.Marshal(b, m, deterministic)
+}
+func (m *PortForwardTerminatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortForwardTerminatedEvent.Merge(m, src)
+}
+func (m *PortForwardTerminatedEvent) XXX_Size() int {
+	return xxx_messageInfo_PortForwardTerminatedEvent.Size(m)
+}
+func (m *PortForwardTerminatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortForwardTerminatedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortForwardTerminatedEvent proto.InternalMessageInfo
+
+func (m *PortForwardTerminatedEvent) GetLocalPort() int32 {
+	if m != nil {
+		return m.LocalPort
+	}
+	return 0
+}
+
+func (m *PortForwardTerminatedEvent) GetRemotePort() int32 {
+	if m != nil {
+		return m.RemotePort
+	}
+	return 0
+}
+
+func (m *PortForwardTerminatedEvent) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *PortForwardTerminatedEvent) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *PortForwardTerminatedEvent) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PortForwardTerminatedEvent) GetPortName() string {
+	if m != nil {
+		return m.PortName
+	}
+	return ""
+}
+
 type LogEntry struct {
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Event                *Event               `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
-	Entry                string               `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Event     *Event               `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Entry     string               `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`
+	// droppedEntries is the number of entries that were not sent to this
+	// subscriber, right before this one, because it fell behind
+	DroppedEntries       int64    `protobuf:"varint,4,opt,name=droppedEntries,proto3" json:"droppedEntries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{14}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{30}
 }
 
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
@@ -885,6 +2171,13 @@ func (m *LogEntry) GetEntry() string {
 	return ""
 }
 
+func (m *LogEntry) GetDroppedEntries() int64 {
+	if m != nil {
+		return m.DroppedEntries
+	}
+	return 0
+}
+
 type UserIntentRequest struct {
 	Intent               *Intent  `protobuf:"bytes,1,opt,name=intent,proto3" json:"intent,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -896,7 +2189,7 @@ func (m *UserIntentRequest) Reset()         { *m = UserIntentRequest{} }
 func (m *UserIntentRequest) String() string { return proto.CompactTextString(m) }
 func (*UserIntentRequest) ProtoMessage()    {}
 func (*UserIntentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{15}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{31}
 }
 
 func (m *UserIntentRequest) XXX_Unmarshal(b []byte) error {
@@ -937,7 +2230,7 @@ func (m *Intent) Reset()         { *m = Intent{} }
 func (m *Intent) String() string { return proto.CompactTextString(m) }
 func (*Intent) ProtoMessage()    {}
 func (*Intent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4f2d38e344f9dbf5, []int{16}
+	return fileDescriptor_4f2d38e344f9dbf5, []int{32}
 }
 
 func (m *Intent) XXX_Unmarshal(b []byte) error {
@@ -985,18 +2278,40 @@ func init() {
 	proto.RegisterType((*Request)(nil), "proto.Request")
 	proto.RegisterType((*State)(nil), "proto.State")
 	proto.RegisterMapType((map[int32]*PortEvent)(nil), "proto.State.ForwardedPortsEntry")
+	proto.RegisterType((*StateChangedEvent)(nil), "proto.StateChangedEvent")
 	proto.RegisterType((*BuildState)(nil), "proto.BuildState")
 	proto.RegisterMapType((map[string]string)(nil), "proto.BuildState.ArtifactsEntry")
+	proto.RegisterMapType((map[string]bool)(nil), "proto.BuildState.CachedEntry")
+	proto.RegisterMapType((map[string]*duration.Duration)(nil), "proto.BuildState.DurationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "proto.BuildState.ImagesEntry")
+	proto.RegisterType((*TestState)(nil), "proto.TestState")
+	proto.RegisterMapType((map[string]string)(nil), "proto.TestState.ArtifactsEntry")
 	proto.RegisterType((*DeployState)(nil), "proto.DeployState")
+	proto.RegisterMapType((map[string]string)(nil), "proto.DeployState.KubeContextsEntry")
+	proto.RegisterType((*DeployTimings)(nil), "proto.DeployTimings")
 	proto.RegisterType((*StatusCheckState)(nil), "proto.StatusCheckState")
 	proto.RegisterMapType((map[string]string)(nil), "proto.StatusCheckState.ResourcesEntry")
 	proto.RegisterType((*Event)(nil), "proto.Event")
 	proto.RegisterType((*MetaEvent)(nil), "proto.MetaEvent")
 	proto.RegisterType((*BuildEvent)(nil), "proto.BuildEvent")
+	proto.RegisterType((*BuildCacheHitEvent)(nil), "proto.BuildCacheHitEvent")
+	proto.RegisterType((*ImagePushProgressEvent)(nil), "proto.ImagePushProgressEvent")
+	proto.RegisterType((*ApplicationLogEvent)(nil), "proto.ApplicationLogEvent")
+	proto.RegisterType((*FileChangedEvent)(nil), "proto.FileChangedEvent")
+	proto.RegisterType((*RunCompleteEvent)(nil), "proto.RunCompleteEvent")
+	proto.RegisterMapType((map[string]string)(nil), "proto.RunCompleteEvent.ImagesEntry")
+	proto.RegisterType((*DeployedResource)(nil), "proto.DeployedResource")
+	proto.RegisterType((*TestEvent)(nil), "proto.TestEvent")
 	proto.RegisterType((*DeployEvent)(nil), "proto.DeployEvent")
+	proto.RegisterType((*DeployRollbackEvent)(nil), "proto.DeployRollbackEvent")
+	proto.RegisterType((*DeployHookEvent)(nil), "proto.DeployHookEvent")
+	proto.RegisterType((*WarningEvent)(nil), "proto.WarningEvent")
+	proto.RegisterType((*StatusCheckSummaryEvent)(nil), "proto.StatusCheckSummaryEvent")
+	proto.RegisterType((*ResourceStatusCheckSummary)(nil), "proto.ResourceStatusCheckSummary")
 	proto.RegisterType((*StatusCheckEvent)(nil), "proto.StatusCheckEvent")
 	proto.RegisterType((*ResourceStatusCheckEvent)(nil), "proto.ResourceStatusCheckEvent")
 	proto.RegisterType((*PortEvent)(nil), "proto.PortEvent")
+	proto.RegisterType((*PortForwardTerminatedEvent)(nil), "proto.PortForwardTerminatedEvent")
 	proto.RegisterType((*LogEntry)(nil), "proto.LogEntry")
 	proto.RegisterType((*UserIntentRequest)(nil), "proto.UserIntentRequest")
 	proto.RegisterType((*Intent)(nil), "proto.Intent")
@@ -1005,71 +2320,139 @@ func init() {
 func init() { proto.RegisterFile("skaffold.proto", fileDescriptor_4f2d38e344f9dbf5) }
 
 var fileDescriptor_4f2d38e344f9dbf5 = []byte{
-	// 1015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0x5e, 0x8f, 0x7f, 0x32, 0x53, 0xce, 0x8f, 0xd3, 0xb0, 0xcb, 0xc8, 0x1b, 0xd8, 0xd0, 0x62,
-	0x57, 0x11, 0x07, 0x7b, 0x37, 0x41, 0xb0, 0x8a, 0x10, 0x12, 0xc9, 0x9a, 0x0d, 0xab, 0x80, 0x50,
-	0x7b, 0x41, 0x5c, 0x10, 0x9a, 0xd8, 0x6d, 0xaf, 0x15, 0x7b, 0x7a, 0x98, 0x6e, 0x07, 0xcc, 0x81,
-	0x03, 0x47, 0xc2, 0x8d, 0xf7, 0xe0, 0x65, 0xb8, 0x71, 0xe6, 0x41, 0x50, 0x57, 0x77, 0x7b, 0x66,
-	0x6c, 0x0f, 0x62, 0x4f, 0xee, 0xaa, 0xfa, 0xea, 0xeb, 0xaa, 0xea, 0xcf, 0xdd, 0x03, 0xbb, 0xf2,
-	0x3a, 0x1a, 0x8d, 0xc4, 0x74, 0xd8, 0x49, 0x52, 0xa1, 0x04, 0xa9, 0xe3, 0x4f, 0xfb, 0x60, 0x2c,
-	0xc4, 0x78, 0xca, 0xbb, 0x51, 0x32, 0xe9, 0x46, 0x71, 0x2c, 0x54, 0xa4, 0x26, 0x22, 0x96, 0x06,
-	0xd4, 0x7e, 0x60, 0xa3, 0x68, 0x5d, 0xcd, 0x47, 0x5d, 0x35, 0x99, 0x71, 0xa9, 0xa2, 0x59, 0x62,
-	0x01, 0xf7, 0x57, 0x01, 0x7c, 0x96, 0xa8, 0x85, 0x09, 0xd2, 0x13, 0xd8, 0xe9, 0xab, 0x48, 0x71,
-	0xc6, 0x65, 0x22, 0x62, 0xc9, 0x09, 0x85, 0xba, 0xd4, 0x8e, 0xb0, 0x72, 0x58, 0x39, 0x6a, 0x1e,
-	0x6f, 0x1b, 0x5c, 0xc7, 0x80, 0x4c, 0x88, 0x1e, 0x80, 0xbf, 0xc4, 0xb7, 0xa0, 0x3a, 0x93, 0x63,
-	0x44, 0x07, 0x4c, 0x2f, 0xe9, 0xdb, 0xb0, 0xc5, 0xf8, 0x0f, 0x73, 0x2e, 0x15, 0x21, 0x50, 0x8b,
-	0xa3, 0x19, 0xb7, 0x51, 0x5c, 0xd3, 0xbf, 0x3d, 0xa8, 0x23, 0x1b, 0x79, 0x02, 0x70, 0x35, 0x9f,
-	0x4c, 0x87, 0xfd, 0xdc, 0x7e, 0xfb, 0x76, 0xbf, 0xb3, 0x65, 0x80, 0xe5, 0x40, 0xe4, 0x03, 0x68,
-	0x0e, 0x79, 0x32, 0x15, 0x0b, 0x93, 0xe3, 0x61, 0x0e, 0xb1, 0x39, 0xcf, 0xb2, 0x08, 0xcb, 0xc3,
-	0xc8, 0x05, 0xec, 0x8e, 0x44, 0xfa, 0x63, 0x94, 0x0e, 0xf9, 0xf0, 0x2b, 0x91, 0x2a, 0x19, 0xd6,
-	0x0e, 0xab, 0x47, 0xcd, 0xe3, 0xc3, 0x7c, 0x73, 0x9d, 0xcf, 0x0a, 0x90, 0x5e, 0xac, 0xd2, 0x05,
-	0x5b, 0xc9, 0x23, 0xe7, 0xd0, 0xd2, 0x23, 0x98, 0xcb, 0xf3, 0x57, 0x7c, 0x70, 0x6d, 0x8a, 0xa8,
-	0x63, 0x11, 0x6f, 0xe5, 0xb8, 0xf2, 0x61, 0xb6, 0x96, 0xd0, 0xee, 0xc3, 0x1b, 0x1b, 0xf6, 0xd2,
-	0x93, 0xbc, 0xe6, 0x0b, 0x9c, 0x43, 0x9d, 0xe9, 0x25, 0x79, 0x04, 0xf5, 0x9b, 0x68, 0x3a, 0x77,
-	0x7d, 0xb6, 0xec, 0x16, 0x3a, 0xa7, 0x77, 0xc3, 0x63, 0xc5, 0x4c, 0xf8, 0xd4, 0x7b, 0x5a, 0x79,
-	0x51, 0xf3, 0xab, 0xad, 0x1a, 0xfd, 0xad, 0x02, 0x90, 0x8d, 0x8e, 0x7c, 0x02, 0x41, 0x94, 0xaa,
-	0xc9, 0x28, 0x1a, 0x28, 0x19, 0x56, 0x0a, 0x3d, 0x67, 0xa8, 0xce, 0xa7, 0x0e, 0x62, 0x7a, 0xce,
-	0x52, 0xda, 0x1f, 0xc3, 0x6e, 0x31, 0x98, 0x2f, 0x32, 0x30, 0x45, 0xbe, 0x99, 0x2f, 0x32, 0xc8,
-	0x95, 0x44, 0x1f, 0x42, 0x33, 0x77, 0x24, 0xe4, 0x1e, 0x34, 0xcc, 0x28, 0x6c, 0xb6, 0xb5, 0xe8,
-	0x9f, 0x15, 0x68, 0xad, 0x4e, 0xad, 0x0c, 0x4c, 0x9e, 0x41, 0x90, 0x72, 0x29, 0xe6, 0xe9, 0x80,
-	0xcb, 0xd0, 0xc3, 0x8e, 0x1e, 0x95, 0x4c, 0xbe, 0xc3, 0x1c, 0xd0, 0xf6, 0xb5, 0x4c, 0xd4, 0x7d,
-	0x15, 0x83, 0xaf, 0xd5, 0xd7, 0xef, 0x55, 0xa8, 0xe3, 0xfc, 0xc9, 0x63, 0x08, 0x66, 0x5c, 0x45,
-	0x68, 0x58, 0x01, 0xbb, 0x43, 0xfa, 0xc2, 0xf9, 0x2f, 0xee, 0xb0, 0x0c, 0x44, 0x4e, 0xac, 0xe6,
-	0x4d, 0x8a, 0xb7, 0xae, 0x79, 0x97, 0x93, 0x83, 0x91, 0x0f, 0x9d, 0xea, 0x4d, 0x56, 0x75, 0x83,
-	0xea, 0x5d, 0x5a, 0x1e, 0xa8, 0xcb, 0x4b, 0x9c, 0x56, 0xc2, 0xda, 0x66, 0x0d, 0xe9, 0xf2, 0x96,
-	0x20, 0xd2, 0x2b, 0xe8, 0xdb, 0x24, 0x96, 0xea, 0xdb, 0xe5, 0xaf, 0xa5, 0x90, 0xef, 0x20, 0x74,
-	0xc3, 0x5e, 0xc5, 0x87, 0x0d, 0xa4, 0x7b, 0x60, 0xe9, 0x58, 0x09, 0xec, 0xe2, 0x0e, 0x2b, 0xa5,
-	0x38, 0xdb, 0x06, 0xe0, 0x7a, 0xf1, 0xbd, 0x5a, 0x24, 0x9c, 0xbe, 0x0b, 0xc1, 0x72, 0xd8, 0xfa,
-	0xd4, 0xb8, 0x3e, 0x50, 0x7b, 0x92, 0xc6, 0xa0, 0xcc, 0xfe, 0x2b, 0x0c, 0xa6, 0x0d, 0xbe, 0x93,
-	0xb8, 0x85, 0x2d, 0xed, 0x9c, 0xee, 0xbc, 0x82, 0xee, 0x5a, 0x50, 0xe5, 0x69, 0x8a, 0xa3, 0x0f,
-	0x98, 0x5e, 0xd2, 0x8f, 0x9c, 0xba, 0x0d, 0x69, 0x99, 0x60, 0x6d, 0xa2, 0x97, 0x25, 0x7e, 0x53,
-	0x90, 0xfb, 0x7f, 0x67, 0x87, 0xb0, 0x35, 0xe3, 0x52, 0x46, 0x63, 0x27, 0x43, 0x67, 0x6e, 0x28,
-	0xe8, 0x67, 0x08, 0xcb, 0xa6, 0xa9, 0x5b, 0x76, 0xd3, 0x74, 0x2d, 0x3b, 0xbb, 0xb4, 0xe5, 0xdc,
-	0xde, 0xd5, 0x8d, 0x7b, 0xd7, 0xb2, 0xbd, 0x6f, 0x3d, 0x08, 0x96, 0x92, 0x22, 0x07, 0x10, 0x4c,
-	0xc5, 0x20, 0x9a, 0x6a, 0x8f, 0xbd, 0xcf, 0x32, 0x07, 0x79, 0x07, 0x20, 0xe5, 0x33, 0xa1, 0x38,
-	0x86, 0x3d, 0x0c, 0xe7, 0x3c, 0x7a, 0xdf, 0x44, 0x0c, 0xbf, 0xd4, 0xef, 0x86, 0xdd, 0xd7, 0x9a,
-	0xe4, 0x3d, 0xd8, 0x19, 0x88, 0x58, 0x45, 0x93, 0x98, 0xa7, 0x18, 0x37, 0x15, 0x14, 0x9d, 0x7a,
-	0x77, 0xfd, 0xd0, 0xc8, 0x24, 0x1a, 0x98, 0xcb, 0x39, 0x60, 0x99, 0x43, 0x4f, 0x42, 0xcb, 0x1d,
-	0xd3, 0x1b, 0x66, 0x12, 0xce, 0x26, 0x14, 0xb6, 0xdd, 0x54, 0x5e, 0x2e, 0x12, 0x1e, 0x6e, 0x61,
-	0xbc, 0xe0, 0xcb, 0x63, 0x90, 0xc3, 0x2f, 0x62, 0xb4, 0x8f, 0xfe, 0x02, 0xfe, 0xa5, 0x18, 0x9b,
-	0x8b, 0xe5, 0x29, 0x04, 0xcb, 0x07, 0xd9, 0x5e, 0x11, 0xed, 0x8e, 0x79, 0x91, 0x3b, 0xee, 0x45,
-	0xee, 0xbc, 0x74, 0x08, 0x96, 0x81, 0xf5, 0x4b, 0xcc, 0x73, 0xb7, 0x84, 0x7b, 0x89, 0xed, 0xcd,
-	0xcf, 0x8b, 0x72, 0xaf, 0xe6, 0xe5, 0x7e, 0x0a, 0xfb, 0x5f, 0x4b, 0x9e, 0x7e, 0x1e, 0x2b, 0x0d,
-	0xb5, 0x6f, 0xf1, 0x43, 0x68, 0x4c, 0xd0, 0x61, 0xab, 0xd8, 0xb1, 0x7c, 0x16, 0x65, 0x83, 0xf4,
-	0x05, 0x34, 0x8c, 0x47, 0x73, 0xe3, 0x1d, 0x84, 0x78, 0x9f, 0x19, 0x43, 0x3f, 0xe9, 0x72, 0x11,
-	0x0f, 0xb0, 0x28, 0x9f, 0xe1, 0x5a, 0x2b, 0xc8, 0x5c, 0x3b, 0x58, 0x86, 0xcf, 0xac, 0x75, 0x7c,
-	0x5b, 0x85, 0xbd, 0xbe, 0xfd, 0xa4, 0xe9, 0xf3, 0xf4, 0x66, 0x32, 0xe0, 0xe4, 0x1c, 0xfc, 0xe7,
-	0x5c, 0xd9, 0x4b, 0x7e, 0x6d, 0x10, 0x3d, 0xfd, 0x69, 0xd2, 0x2e, 0x7c, 0x74, 0xd0, 0xfd, 0x5f,
-	0xff, 0xfa, 0xe7, 0x0f, 0xaf, 0x49, 0x82, 0xee, 0xcd, 0x93, 0x2e, 0x7e, 0x80, 0x90, 0xe7, 0xe0,
-	0xe3, 0x18, 0x2e, 0xc5, 0x98, 0xec, 0x59, 0xb0, 0x9b, 0x78, 0x7b, 0xd5, 0x41, 0xef, 0x22, 0xc1,
-	0x1e, 0xd9, 0xd1, 0x04, 0xe6, 0xe2, 0x98, 0x8a, 0xf1, 0x51, 0xe5, 0x71, 0x85, 0x9c, 0x41, 0x03,
-	0x89, 0xe4, 0xff, 0xa0, 0x21, 0x48, 0xb3, 0x4d, 0x60, 0x49, 0x23, 0x91, 0xe3, 0x12, 0x1a, 0x17,
-	0x51, 0x3c, 0x9c, 0x72, 0x52, 0x38, 0xa2, 0x76, 0x49, 0x77, 0xf4, 0x00, 0x79, 0xee, 0xd1, 0xfd,
-	0x8c, 0xa7, 0xfb, 0x0a, 0x09, 0x4e, 0x2b, 0xef, 0x93, 0x6f, 0x61, 0xab, 0xf7, 0x13, 0x1f, 0xcc,
-	0x15, 0x27, 0xa1, 0xa5, 0x5b, 0x3b, 0xcb, 0x52, 0xea, 0xfb, 0x48, 0x7d, 0x97, 0x36, 0x91, 0xda,
-	0xd0, 0x9c, 0xda, 0x93, 0xbd, 0x6a, 0x20, 0xf8, 0xe4, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f,
-	0xf6, 0x70, 0xb5, 0x66, 0x0a, 0x00, 0x00,
+	// 2101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4d, 0x6f, 0x1c, 0xc7,
+	0xd1, 0xe6, 0xec, 0x17, 0x77, 0x6a, 0x97, 0xe4, 0xb2, 0x69, 0x53, 0xe3, 0x35, 0x2d, 0x53, 0xf3,
+	0xfa, 0x15, 0x84, 0x1c, 0x96, 0xfa, 0x88, 0x03, 0x49, 0x4e, 0x62, 0x58, 0x14, 0xe5, 0x95, 0xad,
+	0x18, 0x42, 0x93, 0x89, 0x8c, 0x00, 0x82, 0x31, 0x9c, 0x6d, 0x2e, 0x07, 0xdc, 0x9d, 0x9e, 0x4c,
+	0xf7, 0x52, 0xda, 0x1c, 0x13, 0x20, 0xf1, 0x35, 0xc8, 0x29, 0xa7, 0x1c, 0x02, 0x24, 0xd7, 0xfc,
+	0x96, 0x24, 0x87, 0xe4, 0x9e, 0x53, 0xf2, 0x27, 0x82, 0xfe, 0x9a, 0xe9, 0x99, 0xdd, 0x11, 0xc9,
+	0xd8, 0x97, 0x9c, 0xb8, 0x5d, 0xfd, 0xd4, 0x33, 0xd5, 0xd5, 0xd5, 0xd5, 0x55, 0x4d, 0x58, 0x67,
+	0x67, 0xc1, 0xc9, 0x09, 0x9d, 0x8c, 0x06, 0x49, 0x4a, 0x39, 0x45, 0x4d, 0xf9, 0xa7, 0xbf, 0x33,
+	0xa6, 0x74, 0x3c, 0x21, 0x7b, 0x41, 0x12, 0xed, 0x05, 0x71, 0x4c, 0x79, 0xc0, 0x23, 0x1a, 0x33,
+	0x05, 0xea, 0xbf, 0xaf, 0x67, 0xe5, 0xe8, 0x78, 0x76, 0xb2, 0xc7, 0xa3, 0x29, 0x61, 0x3c, 0x98,
+	0x26, 0x1a, 0x70, 0xbd, 0x0c, 0x18, 0xcd, 0x52, 0xc9, 0xa0, 0xe7, 0xdf, 0x2d, 0xcf, 0x93, 0x69,
+	0xc2, 0xe7, 0x6a, 0xd2, 0xbf, 0x07, 0x6b, 0x87, 0x3c, 0xe0, 0x04, 0x13, 0x96, 0xd0, 0x98, 0x11,
+	0xe4, 0x43, 0x93, 0x09, 0x81, 0xe7, 0xec, 0x3a, 0xb7, 0x3a, 0x77, 0xbb, 0x0a, 0x37, 0x50, 0x20,
+	0x35, 0xe5, 0xef, 0x40, 0x3b, 0xc3, 0xf7, 0xa0, 0x3e, 0x65, 0x63, 0x89, 0x76, 0xb1, 0xf8, 0xe9,
+	0xbf, 0x07, 0xab, 0x98, 0xfc, 0x6c, 0x46, 0x18, 0x47, 0x08, 0x1a, 0x71, 0x30, 0x25, 0x7a, 0x56,
+	0xfe, 0xf6, 0xff, 0x51, 0x87, 0xa6, 0x64, 0x43, 0x77, 0x00, 0x8e, 0x67, 0xd1, 0x64, 0x74, 0x68,
+	0x7d, 0x6f, 0x53, 0x7f, 0xef, 0x51, 0x36, 0x81, 0x2d, 0x10, 0xfa, 0x2e, 0x74, 0x46, 0x24, 0x99,
+	0xd0, 0xb9, 0xd2, 0xa9, 0x49, 0x1d, 0xa4, 0x75, 0x1e, 0xe7, 0x33, 0xd8, 0x86, 0xa1, 0x21, 0xac,
+	0x9f, 0xd0, 0xf4, 0x55, 0x90, 0x8e, 0xc8, 0xe8, 0x39, 0x4d, 0x39, 0xf3, 0x1a, 0xbb, 0xf5, 0x5b,
+	0x9d, 0xbb, 0xbb, 0xf6, 0xe2, 0x06, 0x4f, 0x0a, 0x90, 0x83, 0x98, 0xa7, 0x73, 0x5c, 0xd2, 0x43,
+	0xfb, 0xd0, 0x13, 0x2e, 0x98, 0xb1, 0xfd, 0x53, 0x12, 0x9e, 0x29, 0x23, 0x9a, 0xd2, 0x88, 0x6b,
+	0x16, 0x97, 0x3d, 0x8d, 0x17, 0x14, 0xd0, 0x00, 0x5c, 0x4e, 0x18, 0x57, 0xda, 0x2d, 0xa9, 0xdd,
+	0xd3, 0xda, 0x47, 0x46, 0x8e, 0x73, 0x08, 0xda, 0x83, 0xf6, 0xab, 0x20, 0x8d, 0xa3, 0x78, 0xcc,
+	0xbc, 0x55, 0x69, 0xf8, 0x96, 0x86, 0xbf, 0x50, 0xe2, 0x83, 0x73, 0x12, 0x73, 0x9c, 0x81, 0xd0,
+	0x0e, 0xb8, 0xe9, 0x2c, 0x56, 0x96, 0x78, 0x6d, 0xe9, 0xfb, 0x5c, 0xd0, 0x3f, 0x84, 0xad, 0x25,
+	0x4b, 0x15, 0x1b, 0x79, 0x46, 0xe6, 0x72, 0x1b, 0x9a, 0x58, 0xfc, 0x44, 0x37, 0xa1, 0x79, 0x1e,
+	0x4c, 0x66, 0xc6, 0xcd, 0xc6, 0x46, 0xa1, 0xa3, 0xbe, 0xa8, 0xa6, 0x1f, 0xd6, 0xee, 0x3b, 0x9f,
+	0x35, 0xda, 0xf5, 0x5e, 0xc3, 0x9f, 0xc2, 0xa6, 0x34, 0x79, 0xff, 0x34, 0x88, 0xc7, 0x64, 0x24,
+	0x51, 0xa8, 0x0f, 0xed, 0x94, 0x9c, 0x47, 0x2c, 0xa2, 0xb1, 0x64, 0xaf, 0xe3, 0x6c, 0x9c, 0x47,
+	0x5b, 0xad, 0x32, 0xda, 0x90, 0x07, 0xab, 0xa1, 0xe2, 0xf3, 0xea, 0xbb, 0xf5, 0x5b, 0x2e, 0x36,
+	0x43, 0xff, 0xd7, 0x0d, 0x80, 0x3c, 0x50, 0xd0, 0x0f, 0xc1, 0x0d, 0x52, 0x1e, 0x9d, 0x04, 0x21,
+	0x67, 0x9e, 0x53, 0xd8, 0xe1, 0x1c, 0x35, 0xf8, 0xc4, 0x40, 0xd4, 0x0e, 0xe7, 0x2a, 0x42, 0xdf,
+	0x1c, 0x1d, 0xe6, 0xd5, 0xaa, 0xf4, 0x1f, 0x1b, 0x88, 0xd6, 0xcf, 0x54, 0xd0, 0x87, 0xd0, 0x8a,
+	0xa6, 0xc1, 0x98, 0x30, 0x69, 0x67, 0xe7, 0xee, 0x7b, 0x8b, 0xca, 0x4f, 0xe5, 0xbc, 0xd2, 0xd4,
+	0x60, 0xa1, 0x16, 0x06, 0xe1, 0x29, 0x19, 0x79, 0x8d, 0x2a, 0xb5, 0x7d, 0x39, 0xaf, 0xd5, 0x14,
+	0xb8, 0xff, 0x7d, 0x58, 0x2f, 0x2e, 0xc5, 0xde, 0x41, 0x57, 0xed, 0xe0, 0x5b, 0xf6, 0x0e, 0xba,
+	0xd6, 0x7e, 0xf5, 0x5f, 0xc0, 0x7a, 0x71, 0x21, 0x4b, 0xb4, 0xf7, 0x8a, 0xfb, 0xff, 0xce, 0x40,
+	0x25, 0x92, 0x81, 0x49, 0x24, 0x99, 0x2b, 0x6c, 0xe2, 0x07, 0xd0, 0xb1, 0x16, 0x79, 0x25, 0x9b,
+	0x1e, 0x40, 0xc7, 0x5a, 0xe8, 0x45, 0xaa, 0x6d, 0x4b, 0xd5, 0xff, 0xda, 0x01, 0x37, 0x3b, 0x3b,
+	0xe8, 0x07, 0x8b, 0x81, 0xf0, 0x7e, 0xf9, 0x80, 0x55, 0xc7, 0xc1, 0x37, 0xf3, 0xac, 0xff, 0x75,
+	0x0d, 0x3a, 0x56, 0x26, 0x42, 0xdb, 0xd0, 0x52, 0x19, 0x40, 0xab, 0xeb, 0x11, 0xba, 0x09, 0xeb,
+	0x29, 0x9d, 0x4c, 0x8e, 0x03, 0x95, 0x16, 0x66, 0x4c, 0x53, 0x95, 0xa4, 0x68, 0x08, 0xdd, 0xb3,
+	0xd9, 0x31, 0xd9, 0xa7, 0x31, 0x27, 0xaf, 0xb9, 0x89, 0xad, 0x0f, 0x16, 0x73, 0xde, 0xe0, 0x73,
+	0x0b, 0xa6, 0x16, 0x55, 0xd0, 0x44, 0x03, 0x58, 0xe5, 0xd1, 0x54, 0xa6, 0x91, 0x86, 0xdc, 0xd1,
+	0xb7, 0x0a, 0x24, 0x47, 0x6a, 0x0e, 0x1b, 0x50, 0xff, 0x63, 0xd8, 0x5c, 0xa0, 0xbc, 0x92, 0x2b,
+	0xfe, 0xec, 0xc0, 0x5a, 0x81, 0x1b, 0xdd, 0x81, 0x56, 0x4a, 0xe2, 0x11, 0x49, 0x3d, 0xe7, 0xa2,
+	0x98, 0xd2, 0x40, 0x11, 0x85, 0x41, 0x92, 0x4c, 0xe6, 0x97, 0x88, 0x42, 0x89, 0x43, 0x1f, 0x41,
+	0xc7, 0x4a, 0xb9, 0x5e, 0xfd, 0x22, 0x35, 0x1b, 0xed, 0xff, 0xbd, 0x06, 0xbd, 0x72, 0x0a, 0xaf,
+	0xdc, 0xc2, 0xc7, 0xe0, 0xa6, 0x84, 0xd1, 0x59, 0x1a, 0x12, 0x93, 0x30, 0x6e, 0x56, 0x5c, 0x03,
+	0x03, 0x6c, 0x80, 0x3a, 0xdc, 0x32, 0x45, 0x74, 0x1f, 0x56, 0xd9, 0x6c, 0x3a, 0x0d, 0xd2, 0xb9,
+	0xb6, 0xf5, 0xfa, 0x12, 0x0e, 0x05, 0x50, 0x69, 0xd7, 0xc0, 0x45, 0x08, 0x71, 0xca, 0x83, 0x49,
+	0xc6, 0x2d, 0xf7, 0xb5, 0x89, 0x4b, 0x52, 0x81, 0x4b, 0x49, 0x30, 0x9a, 0xe7, 0xb8, 0xa6, 0xc2,
+	0x15, 0xa5, 0xe8, 0x3a, 0x40, 0x42, 0xd2, 0x90, 0xc4, 0x3c, 0x18, 0xab, 0x9b, 0xa9, 0x89, 0x2d,
+	0x89, 0x38, 0x18, 0xc5, 0x65, 0x5c, 0x29, 0x1a, 0xfe, 0xe2, 0x42, 0x53, 0xdd, 0x08, 0xb7, 0xc1,
+	0x9d, 0x12, 0x1e, 0xc8, 0x81, 0xe7, 0x14, 0x2e, 0x97, 0x1f, 0x19, 0xf9, 0x70, 0x05, 0xe7, 0x20,
+	0x74, 0x4f, 0x97, 0x0a, 0x4a, 0xa5, 0xb6, 0x58, 0x2a, 0x18, 0x1d, 0x0b, 0x86, 0xbe, 0x67, 0x8a,
+	0x05, 0xa5, 0x55, 0x5f, 0x52, 0x2c, 0x18, 0x35, 0x1b, 0x28, 0xcc, 0x4b, 0xcc, 0x1d, 0xe7, 0x35,
+	0x0a, 0xe6, 0x65, 0x77, 0x9f, 0x30, 0x2f, 0x03, 0xa1, 0x83, 0x42, 0x59, 0xa0, 0x14, 0x2b, 0xcb,
+	0x02, 0xa3, 0xbf, 0xa0, 0x82, 0x5e, 0x82, 0x67, 0xc2, 0xa2, 0x8c, 0xd7, 0x75, 0x82, 0x49, 0x63,
+	0xb8, 0x02, 0x36, 0x5c, 0xc1, 0x95, 0x14, 0x62, 0x5d, 0x9c, 0x30, 0xbd, 0xae, 0xd5, 0x85, 0xba,
+	0x23, 0x5b, 0x57, 0x06, 0x42, 0x5f, 0xc0, 0x96, 0x72, 0x0c, 0xd6, 0x39, 0x49, 0xe9, 0xb6, 0xa5,
+	0x6e, 0xbf, 0xe0, 0xc9, 0x02, 0x62, 0xb8, 0x82, 0x97, 0x29, 0xa2, 0x10, 0xfa, 0xc2, 0x69, 0xba,
+	0xfc, 0x38, 0x22, 0xe9, 0x34, 0x8a, 0x03, 0xae, 0x0b, 0x05, 0xcf, 0x95, 0xb4, 0x37, 0x2c, 0x57,
+	0x2f, 0x07, 0x0e, 0x57, 0xf0, 0x1b, 0x68, 0xd0, 0x23, 0xd8, 0x50, 0xdf, 0x1e, 0x52, 0xaa, 0x0d,
+	0x06, 0xc9, 0xbc, 0x5d, 0x30, 0x38, 0x9b, 0x1d, 0xae, 0xe0, 0xb2, 0x02, 0x7a, 0x00, 0xdd, 0x57,
+	0x56, 0x6d, 0xe5, 0x75, 0x76, 0x9d, 0x8a, 0xb2, 0x6b, 0xb8, 0x82, 0x0b, 0x50, 0xf4, 0x53, 0xb8,
+	0xc6, 0x96, 0x1f, 0x5c, 0xaf, 0x7b, 0x99, 0xe3, 0x3d, 0x5c, 0xc1, 0x55, 0x04, 0xe8, 0x73, 0x40,
+	0x32, 0xbe, 0xe5, 0x35, 0x39, 0x8c, 0xf4, 0x56, 0xae, 0xe9, 0x0c, 0x67, 0x1d, 0x87, 0x02, 0x60,
+	0xb8, 0x82, 0x97, 0xa8, 0xa1, 0x17, 0xb0, 0x2d, 0x2b, 0x90, 0xe7, 0x33, 0x76, 0xfa, 0x3c, 0xa5,
+	0xe3, 0x94, 0x30, 0xa6, 0x08, 0xd7, 0x77, 0x1d, 0xab, 0x0e, 0x79, 0xba, 0x14, 0x34, 0x5c, 0xc1,
+	0x15, 0xea, 0x22, 0x6a, 0x44, 0x26, 0x8e, 0x42, 0x99, 0x5f, 0x9f, 0x51, 0xed, 0xc3, 0x8d, 0x42,
+	0xd4, 0x7c, 0xb2, 0x88, 0x10, 0x51, 0xb3, 0x44, 0x51, 0x9c, 0xae, 0x93, 0x68, 0x52, 0x28, 0x2a,
+	0xbd, 0x5e, 0xe1, 0x74, 0x3d, 0x29, 0x4d, 0x8b, 0xd3, 0x55, 0x56, 0x11, 0x34, 0xe9, 0x2c, 0xde,
+	0xa7, 0xd3, 0x64, 0x42, 0x38, 0x51, 0x34, 0x9b, 0x05, 0x1a, 0x5c, 0x9a, 0x16, 0x34, 0x65, 0x95,
+	0x47, 0x5d, 0x00, 0x22, 0x7e, 0x7c, 0xc5, 0xe7, 0x09, 0xf1, 0x6f, 0x80, 0x9b, 0xa5, 0x2c, 0x91,
+	0xfb, 0x88, 0x48, 0x8b, 0x3a, 0x1f, 0xaa, 0x81, 0xff, 0x7b, 0x47, 0x57, 0xa9, 0x59, 0x39, 0x6c,
+	0x4a, 0x0d, 0x8d, 0xcb, 0xc6, 0xd6, 0x45, 0x53, 0x2b, 0x5c, 0x34, 0x3d, 0xa8, 0x93, 0x34, 0x95,
+	0x19, 0xcc, 0xc5, 0xe2, 0x27, 0xfa, 0x10, 0xda, 0xa6, 0xf0, 0xf4, 0x1a, 0x17, 0xdd, 0x70, 0x19,
+	0x54, 0x58, 0x28, 0x37, 0x4d, 0x66, 0x27, 0x17, 0xab, 0x81, 0xff, 0x04, 0xd0, 0x62, 0xd4, 0xbc,
+	0xd1, 0xd0, 0x8c, 0xa7, 0x66, 0xf3, 0xfc, 0xca, 0x81, 0xed, 0xe5, 0xd1, 0x92, 0x2b, 0x38, 0x96,
+	0x82, 0x90, 0x4e, 0x82, 0x39, 0x49, 0x0d, 0x8d, 0x1c, 0xa0, 0x5d, 0xe8, 0x1c, 0xcf, 0x39, 0x61,
+	0x82, 0x45, 0x16, 0xfd, 0xa2, 0x67, 0xb0, 0x45, 0xe2, 0xa2, 0x92, 0xc3, 0x23, 0x71, 0xcf, 0xc9,
+	0xf5, 0xd7, 0xb1, 0x25, 0xf1, 0x29, 0x6c, 0x2d, 0x89, 0x2f, 0xd1, 0x49, 0x24, 0x74, 0xf4, 0x45,
+	0xde, 0x91, 0x9a, 0x21, 0xfa, 0x00, 0xd6, 0x42, 0x1a, 0xf3, 0x20, 0x8a, 0x49, 0x2a, 0xe7, 0x95,
+	0x41, 0x45, 0xa1, 0xd0, 0x9f, 0x12, 0xc6, 0xc4, 0x32, 0xd4, 0x56, 0x98, 0xa1, 0xff, 0x04, 0x7a,
+	0xe5, 0x18, 0x14, 0x8b, 0x4b, 0x02, 0x7e, 0xaa, 0x2a, 0x50, 0x17, 0xab, 0x81, 0xe8, 0xcd, 0xf2,
+	0xda, 0xb4, 0x26, 0x67, 0x72, 0x81, 0xff, 0x6f, 0x07, 0x7a, 0xe5, 0x28, 0xac, 0x2c, 0x3f, 0x74,
+	0x54, 0xd4, 0xf2, 0xa8, 0xf8, 0xa8, 0xd4, 0x81, 0xfc, 0x5f, 0x45, 0x60, 0x57, 0xf4, 0x21, 0x56,
+	0x35, 0xa3, 0x5a, 0x91, 0x6b, 0x85, 0x8c, 0x49, 0x46, 0xe6, 0xda, 0xb1, 0xca, 0x97, 0x6f, 0x50,
+	0xf0, 0xfb, 0xaf, 0xa1, 0x57, 0x66, 0x16, 0x5b, 0x1b, 0x24, 0xd1, 0x4f, 0x48, 0x9a, 0xf5, 0x8b,
+	0x2e, 0xb6, 0x24, 0xe2, 0x49, 0xe1, 0x2c, 0x8a, 0x47, 0x9a, 0x4c, 0xfe, 0x16, 0x3e, 0x15, 0x4f,
+	0x0b, 0x2c, 0x09, 0x42, 0xb3, 0x33, 0xb9, 0x20, 0x7b, 0x84, 0x68, 0x58, 0x8f, 0x10, 0xbf, 0xd4,
+	0xfd, 0xc2, 0xb7, 0x79, 0x24, 0x45, 0x9f, 0x4a, 0xa7, 0xd3, 0x20, 0x1e, 0xe9, 0x4f, 0x99, 0xa1,
+	0xe0, 0xa0, 0x33, 0x9e, 0xcc, 0xb8, 0x3e, 0x76, 0x7a, 0xe4, 0xff, 0xc1, 0x31, 0xad, 0xc2, 0x55,
+	0x37, 0x7a, 0x17, 0x3a, 0x56, 0x69, 0xaf, 0xad, 0xb0, 0x45, 0xc2, 0x9a, 0x80, 0x73, 0xf1, 0xd4,
+	0xa3, 0x8b, 0x42, 0x33, 0xb4, 0xdb, 0x80, 0xe6, 0x25, 0xda, 0x00, 0xff, 0x25, 0x6c, 0x2d, 0xb9,
+	0xe2, 0xaf, 0x60, 0xec, 0x8e, 0x1d, 0x58, 0xaa, 0x85, 0xcf, 0x05, 0xfe, 0x19, 0x6c, 0x94, 0x2e,
+	0x64, 0x79, 0x72, 0x4e, 0x03, 0x96, 0x25, 0x0b, 0x39, 0xb0, 0xfd, 0x5b, 0x5b, 0xf0, 0xaf, 0x36,
+	0xa5, 0xbe, 0xcc, 0x94, 0x46, 0x66, 0x8a, 0xff, 0x25, 0x74, 0xed, 0xcb, 0x5b, 0xec, 0x7c, 0x18,
+	0x70, 0x32, 0xa6, 0x59, 0xd2, 0xce, 0xc6, 0x22, 0x6e, 0x42, 0x3a, 0x32, 0x61, 0x2b, 0x7f, 0xbf,
+	0x21, 0x03, 0xfc, 0xc6, 0x81, 0x6b, 0x15, 0x37, 0x3a, 0xfa, 0xd8, 0x76, 0x80, 0xea, 0x47, 0x6f,
+	0x54, 0x17, 0x72, 0x5a, 0xd5, 0x6e, 0x11, 0xec, 0x6c, 0x5f, 0xbb, 0x74, 0xb6, 0xf7, 0x7f, 0xe7,
+	0x40, 0xbf, 0xfa, 0x03, 0xea, 0x61, 0x46, 0xcd, 0x9a, 0xc5, 0x9b, 0x71, 0x65, 0xd8, 0xdb, 0x96,
+	0xd4, 0x2f, 0x7f, 0xef, 0x2c, 0xee, 0xc4, 0x1f, 0x9d, 0x42, 0xa3, 0xf5, 0xe6, 0x98, 0xb2, 0xdc,
+	0x5e, 0x2b, 0xb8, 0x7d, 0xc9, 0x31, 0xfc, 0x96, 0x9b, 0x22, 0xff, 0xe7, 0xe0, 0x55, 0x55, 0xdb,
+	0xff, 0x95, 0x07, 0x2b, 0x43, 0x68, 0x89, 0x93, 0xfe, 0x54, 0x03, 0x37, 0x6b, 0x39, 0xc4, 0x39,
+	0x9a, 0xd0, 0x30, 0x98, 0x08, 0x89, 0x7e, 0xa7, 0xcb, 0x05, 0x22, 0x71, 0xa6, 0x64, 0x4a, 0x39,
+	0x91, 0xd3, 0x35, 0x39, 0x6d, 0x49, 0xec, 0xcb, 0xaf, 0x7e, 0xc1, 0xe5, 0xd7, 0x58, 0x76, 0xf9,
+	0x15, 0x92, 0x6c, 0xb3, 0x9c, 0x64, 0xfb, 0xd0, 0x4e, 0x68, 0xca, 0xa5, 0x7a, 0x4b, 0x79, 0xc2,
+	0x8c, 0x91, 0x0f, 0x5d, 0xe3, 0x95, 0xa3, 0x79, 0x42, 0x64, 0xeb, 0xe1, 0xe2, 0x82, 0xcc, 0xc6,
+	0x48, 0x8e, 0x76, 0x11, 0x23, 0x79, 0xc4, 0x37, 0x44, 0x8c, 0x85, 0x74, 0xe2, 0xb9, 0xfa, 0x1b,
+	0x7a, 0xec, 0xff, 0xcd, 0x81, 0x7e, 0x75, 0xc7, 0xf0, 0xbf, 0xea, 0x3a, 0x71, 0x4a, 0xda, 0xa2,
+	0x7c, 0x91, 0x57, 0xeb, 0x7d, 0x70, 0xb3, 0xb7, 0x7f, 0xdd, 0x36, 0xf7, 0x17, 0x0e, 0xdf, 0x91,
+	0x41, 0xe0, 0x1c, 0x2c, 0x9e, 0x59, 0x89, 0xd5, 0x39, 0x9b, 0x67, 0x56, 0xfd, 0x8a, 0x4b, 0x8a,
+	0xc5, 0x6b, 0xdd, 0x2a, 0x5e, 0xc5, 0x29, 0x19, 0xa5, 0x34, 0x49, 0xd4, 0xa3, 0x5c, 0xa4, 0x4f,
+	0x53, 0x1d, 0x97, 0xa4, 0xfe, 0x43, 0xd8, 0xfc, 0x31, 0x23, 0xe9, 0xd3, 0x98, 0x0b, 0x4a, 0xfd,
+	0xfc, 0xff, 0xff, 0xd0, 0x8a, 0xa4, 0x40, 0x5b, 0xbb, 0x66, 0x3a, 0x0a, 0x85, 0xd2, 0x93, 0xfe,
+	0x67, 0xd0, 0x52, 0x12, 0x61, 0x83, 0x6c, 0x54, 0x24, 0xbe, 0x8d, 0xd5, 0x40, 0x24, 0x62, 0x36,
+	0x8f, 0x43, 0xfd, 0xea, 0x27, 0x7f, 0x8b, 0xd3, 0xa5, 0x7a, 0x36, 0x69, 0x6e, 0x1b, 0xeb, 0xd1,
+	0xdd, 0x7f, 0xd5, 0x61, 0xe3, 0x50, 0xff, 0x97, 0xe5, 0x90, 0xa4, 0xe7, 0x51, 0x48, 0xd0, 0x3e,
+	0xb4, 0x3f, 0x25, 0xfa, 0x69, 0x70, 0x7b, 0xc1, 0x61, 0x07, 0xe2, 0xbf, 0x21, 0xfd, 0xc2, 0xcb,
+	0xb3, 0xbf, 0xf9, 0x8b, 0xbf, 0xfe, 0xf3, 0xb7, 0xb5, 0x0e, 0x72, 0xf7, 0xce, 0xef, 0xec, 0xa9,
+	0x57, 0xe8, 0x97, 0xd0, 0xb5, 0x9e, 0xb6, 0x59, 0x25, 0x91, 0x67, 0x13, 0xd9, 0xf5, 0xa0, 0xff,
+	0x8e, 0x24, 0xdd, 0x42, 0x9b, 0x19, 0xe9, 0x57, 0xea, 0x21, 0x9b, 0xdd, 0x76, 0xd0, 0xa7, 0xd0,
+	0x96, 0xa8, 0x67, 0x74, 0x8c, 0x36, 0x34, 0x85, 0xd9, 0xf8, 0x7e, 0x59, 0xe0, 0xbf, 0x2d, 0xa9,
+	0x36, 0xd0, 0x9a, 0xa0, 0x52, 0xdd, 0xc8, 0x84, 0x8e, 0x6f, 0x39, 0xb7, 0x1d, 0xf4, 0x08, 0x5a,
+	0x92, 0x88, 0x5d, 0x82, 0x06, 0x49, 0x9a, 0x2e, 0x82, 0x8c, 0x86, 0x49, 0x8e, 0x67, 0xd0, 0x1a,
+	0x06, 0xf1, 0x68, 0x42, 0x50, 0x21, 0x52, 0xfa, 0x15, 0x6b, 0xf6, 0x77, 0x24, 0xcf, 0xb6, 0xbf,
+	0x99, 0xf3, 0xec, 0x9d, 0x4a, 0x82, 0x87, 0xce, 0x77, 0xd0, 0x97, 0xb0, 0x7a, 0xf0, 0x9a, 0x84,
+	0x33, 0x4e, 0x90, 0x71, 0xce, 0x42, 0xa8, 0x54, 0x52, 0xbf, 0x2b, 0xa9, 0xdf, 0xf6, 0x3b, 0x92,
+	0x5a, 0xd1, 0x3c, 0xd4, 0x81, 0x73, 0xdc, 0x92, 0xe0, 0x7b, 0xff, 0x19, 0x00, 0x31, 0x87, 0xd3,
+	0x96, 0x58, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1085,6 +2468,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type SkaffoldServiceClient interface {
 	GetState(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*State, error)
+	StateChanges(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SkaffoldService_StateChangesClient, error)
 	EventLog(ctx context.Context, opts ...grpc.CallOption) (SkaffoldService_EventLogClient, error)
 	Events(ctx context.Context, opts ...grpc.CallOption) (SkaffoldService_EventsClient, error)
 	Handle(ctx context.Context, in *Event, opts ...grpc.CallOption) (*empty.Empty, error)
@@ -1108,8 +2492,40 @@ func (c *skaffoldServiceClient) GetState(ctx context.Context, in *empty.Empty, o
 	return out, nil
 }
 
+func (c *skaffoldServiceClient) StateChanges(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SkaffoldService_StateChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SkaffoldService_serviceDesc.Streams[0], "/proto.SkaffoldService/StateChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &skaffoldServiceStateChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SkaffoldService_StateChangesClient interface {
+	Recv() (*StateChangedEvent, error)
+	grpc.ClientStream
+}
+
+type skaffoldServiceStateChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *skaffoldServiceStateChangesClient) Recv() (*StateChangedEvent, error) {
+	m := new(StateChangedEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *skaffoldServiceClient) EventLog(ctx context.Context, opts ...grpc.CallOption) (SkaffoldService_EventLogClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_SkaffoldService_serviceDesc.Streams[0], "/proto.SkaffoldService/EventLog", opts...)
+	stream, err := c.cc.NewStream(ctx, &_SkaffoldService_serviceDesc.Streams[1], "/proto.SkaffoldService/EventLog", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1140,7 +2556,7 @@ func (x *skaffoldServiceEventLogClient) Recv() (*LogEntry, error) {
 }
 
 func (c *skaffoldServiceClient) Events(ctx context.Context, opts ...grpc.CallOption) (SkaffoldService_EventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_SkaffoldService_serviceDesc.Streams[1], "/proto.SkaffoldService/Events", opts...)
+	stream, err := c.cc.NewStream(ctx, &_SkaffoldService_serviceDesc.Streams[2], "/proto.SkaffoldService/Events", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1191,6 +2607,7 @@ func (c *skaffoldServiceClient) Execute(ctx context.Context, in *UserIntentReque
 // SkaffoldServiceServer is the server API for SkaffoldService service.
 type SkaffoldServiceServer interface {
 	GetState(context.Context, *empty.Empty) (*State, error)
+	StateChanges(*empty.Empty, SkaffoldService_StateChangesServer) error
 	EventLog(SkaffoldService_EventLogServer) error
 	Events(SkaffoldService_EventsServer) error
 	Handle(context.Context, *Event) (*empty.Empty, error)
@@ -1204,6 +2621,9 @@ type UnimplementedSkaffoldServiceServer struct {
 func (*UnimplementedSkaffoldServiceServer) GetState(ctx context.Context, req *empty.Empty) (*State, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetState not implemented")
 }
+func (*UnimplementedSkaffoldServiceServer) StateChanges(req *empty.Empty, srv SkaffoldService_StateChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StateChanges not implemented")
+}
 func (*UnimplementedSkaffoldServiceServer) EventLog(srv SkaffoldService_EventLogServer) error {
 	return status.Errorf(codes.Unimplemented, "method EventLog not implemented")
 }
@@ -1239,6 +2659,27 @@ func _SkaffoldService_GetState_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SkaffoldService_StateChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SkaffoldServiceServer).StateChanges(m, &skaffoldServiceStateChangesServer{stream})
+}
+
+type SkaffoldService_StateChangesServer interface {
+	Send(*StateChangedEvent) error
+	grpc.ServerStream
+}
+
+type skaffoldServiceStateChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *skaffoldServiceStateChangesServer) Send(m *StateChangedEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _SkaffoldService_EventLog_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(SkaffoldServiceServer).EventLog(&skaffoldServiceEventLogServer{stream})
 }
@@ -1345,6 +2786,11 @@ var _SkaffoldService_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StateChanges",
+			Handler:       _SkaffoldService_StateChanges_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "EventLog",
 			Handler:       _SkaffoldService_EventLog_Handler,
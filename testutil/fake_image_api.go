@@ -45,6 +45,7 @@ type FakeAPIClient struct {
 	nextImageID int
 	Pushed      map[string]string
 	Built       []types.ImageBuildOptions
+	Removed     []string
 }
 
 func (f *FakeAPIClient) Add(tag, imageID string) *FakeAPIClient {
@@ -178,4 +179,15 @@ func (f *FakeAPIClient) Info(context.Context) (types.Info, error) {
 	}, nil
 }
 
+func (f *FakeAPIClient) ImageRemove(_ context.Context, image string, _ types.ImageRemoveOptions) ([]types.ImageDeleteResponseItem, error) {
+	imageID, found := f.tagToImageID[image]
+	if !found {
+		return nil, &notFoundError{}
+	}
+
+	delete(f.tagToImageID, image)
+	f.Removed = append(f.Removed, image)
+	return []types.ImageDeleteResponseItem{{Untagged: image}, {Deleted: imageID}}, nil
+}
+
 func (f *FakeAPIClient) Close() error { return nil }